@@ -29,6 +29,11 @@ func (c *baseCmd) Cmd() *cobra.Command {
 			return err
 		}
 
+		// Fail early on --output formats the command cannot print
+		if _, err := outputFormat(cmd); err != nil {
+			return err
+		}
+
 		if shouldSkipValidation(cmd.Name()) {
 			return nil
 		}
@@ -80,23 +85,25 @@ func validateCommandSpecificConfig(cmdName string) error {
 	cfg := config.GetConfig()
 
 	switch cmdName {
-	case "request":
-		// review request command needs review webhook
-		if cfg.GChatReviewWebhookURL == "" {
-			fmt.Println("⚠️  GChat review webhook URL is not configured.")
-			fmt.Println("Please run the setup command to configure it:")
+	case "request", "amend":
+		// review request and amend commands need a review channel
+		if _, err := cfg.StageChannels(config.StageReview); err != nil {
+			fmt.Println("⚠️  No review notification channel is configured.")
+			fmt.Println("Please run the setup command to configure the GChat webhook:")
 			fmt.Println("   cool setup webhook")
+			fmt.Println("or add channels to ~/.cool-cli/config.json (see 'cool config preview').")
 			fmt.Println()
-			return fmt.Errorf("missing GChat review webhook URL")
+			return fmt.Errorf("missing review channel: %w", err)
 		}
 	case "submit-collab":
-		// review submit-collab command needs collab webhook
-		if cfg.GChatCollabWebhookURL == "" {
-			fmt.Println("⚠️  GChat collaboration webhook URL is not configured.")
-			fmt.Println("Please run the setup command to configure it:")
+		// review submit-collab command needs a collaboration channel
+		if _, err := cfg.StageChannels(config.StageCollab); err != nil {
+			fmt.Println("⚠️  No collaboration notification channel is configured.")
+			fmt.Println("Please run the setup command to configure the GChat webhook:")
 			fmt.Println("   cool setup webhook")
+			fmt.Println("or add channels to ~/.cool-cli/config.json (see 'cool config preview').")
 			fmt.Println()
-			return fmt.Errorf("missing GChat collaboration webhook URL")
+			return fmt.Errorf("missing collaboration channel: %w", err)
 		}
 	}
 
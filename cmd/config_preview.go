@@ -2,6 +2,8 @@ package cmd
 
 import (
 	"fmt"
+	"net/url"
+	"strings"
 
 	"github.com/spf13/cobra"
 	"github.com/yatbfi/cool/config"
@@ -15,10 +17,11 @@ type ConfigPreviewCmd struct {
 func NewConfigPreviewCmd() *ConfigPreviewCmd {
 	cmd := &ConfigPreviewCmd{}
 	cmd.baseCmd = newBaseCommand(&cobra.Command{
-		Use:   "preview",
-		Short: "Preview current configuration",
-		Long:  `Display all current configuration settings stored in ~/.cool-cli/config.json`,
-		RunE:  cmd.run,
+		Use:         "preview",
+		Short:       "Preview current configuration",
+		Long:        `Display all current configuration settings stored in ~/.cool-cli/config.json`,
+		Annotations: outputFormats(outputJSON, outputYAML),
+		RunE:        cmd.run,
 	})
 	return cmd
 }
@@ -26,6 +29,14 @@ func NewConfigPreviewCmd() *ConfigPreviewCmd {
 func (c *ConfigPreviewCmd) run(cmd *cobra.Command, args []string) error {
 	cfg := config.GetConfig()
 
+	format, err := outputFormat(cmd)
+	if err != nil {
+		return err
+	}
+	if isStructuredOutput(format) {
+		return printStructured(format, newConfigPreviewOutput(cfg))
+	}
+
 	fmt.Println()
 	fmt.Println("📋 Current Configuration")
 	fmt.Println("========================")
@@ -59,6 +70,113 @@ func (c *ConfigPreviewCmd) run(cmd *cobra.Command, args []string) error {
 	} else {
 		fmt.Println("   Collab Webhook : (not set)")
 	}
+
+	messageFormat := cfg.GChatMessageFormat
+	if messageFormat == "" {
+		messageFormat = config.MessageFormatCards + " (default)"
+	}
+	fmt.Printf("   Message Format : %s\n", messageFormat)
+	fmt.Println()
+
+	// Notification channels
+	fmt.Println("📣 Notification Channels:")
+	for _, ch := range cfg.Channels {
+		fmt.Printf("   %-14s : %s %s\n", ch.Name, ch.Type, webhookHost(ch.WebhookURL))
+	}
+	for _, stage := range []string{config.StageReview, config.StageCollab} {
+		channels, err := cfg.StageChannels(stage)
+		if err != nil {
+			fmt.Printf("   Stage %-8s : (%s)\n", stage, err.Error())
+			continue
+		}
+		var names []string
+		for _, ch := range channels {
+			names = append(names, fmt.Sprintf("%s (%s)", ch.Name, ch.Type))
+		}
+		fmt.Printf("   Stage %-8s : %s\n", stage, strings.Join(names, ", "))
+	}
+	fmt.Println()
+
+	// Routing rules
+	if len(cfg.Routes) > 0 {
+		fmt.Println("🧭 Routes (first match wins):")
+		for _, route := range cfg.Routes {
+			fmt.Printf("   %-14s : %s\n", route.Name, formatRouteConditions(route))
+			for _, stage := range []string{config.StageReview, config.StageCollab} {
+				if names, ok := route.Stages[stage]; ok {
+					fmt.Printf("   %-14s   %s → %s\n", "", stage, strings.Join(names, ", "))
+				}
+			}
+			if route.Squad != "" {
+				fmt.Printf("   %-14s   squad %s\n", "", route.Squad)
+			}
+		}
+		fmt.Println()
+	}
+
+	// Team roster
+	fmt.Println("👥 Team:")
+	if len(cfg.Team) > 0 {
+		roles := map[string]int{}
+		for _, m := range cfg.Team {
+			roles[m.Role]++
+		}
+		fmt.Printf("   Members        : %d (%d tech lead(s), %d architect(s)), see 'cool team list'\n",
+			len(cfg.Team), roles[config.RoleTechLead], roles[config.RoleArchitect])
+	} else {
+		fmt.Println("   Members        : (none, reviewers are not mentioned; add them with 'cool team add')")
+	}
+	fmt.Println()
+
+	// Links
+	fmt.Println("🎫 Link Settings:")
+	if cfg.JiraBaseURL != "" {
+		fmt.Printf("   Jira Base URL : %s\n", cfg.JiraBaseURL)
+	} else {
+		fmt.Println("   Jira Base URL : (not set, bare keys like ABC-123 are rejected)")
+	}
+	fmt.Printf("   PR Hosts      : %s\n", strings.Join(cfg.KnownPRHosts(), ", "))
+	if cfg.JiraAPIConfigured() {
+		user, _ := cfg.JiraCredentials()
+		if user == "" {
+			user = "personal access token"
+		}
+		fmt.Printf("   Jira API      : token set (%s)\n", user)
+	} else {
+		fmt.Println("   Jira API      : (no jira_base_url/jira_token, issue details are not fetched)")
+	}
+	for _, stage := range []string{config.StageReview, config.StageCollab} {
+		action, ok := cfg.JiraActions[stage]
+		if !ok {
+			continue
+		}
+		var steps []string
+		if action.Transition != "" {
+			steps = append(steps, fmt.Sprintf("transition to %q", action.Transition))
+		}
+		if action.Comment {
+			steps = append(steps, "comment")
+		}
+		fmt.Printf("   Jira on %-6s: %s\n", stage, strings.Join(steps, ", "))
+	}
+	fmt.Println()
+
+	// Code host APIs
+	fmt.Println("🔀 Code Host API:")
+	for _, api := range []struct{ name, kind, url string }{
+		{"GitHub", config.CodeHostGitHub, cfg.GitHubAPIURL},
+		{"GitLab", config.CodeHostGitLab, cfg.GitLabAPIURL},
+	} {
+		apiURL := api.url
+		if apiURL == "" {
+			apiURL = "default"
+		}
+		if cfg.CodeHostToken(api.kind) != "" {
+			fmt.Printf("   %s : token set (API: %s)\n", api.name, apiURL)
+		} else {
+			fmt.Printf("   %s : (no token, pull request details are not fetched)\n", api.name)
+		}
+	}
 	fmt.Println()
 
 	// Editor
@@ -117,3 +235,156 @@ func (c *ConfigPreviewCmd) run(cmd *cobra.Command, args []string) error {
 
 	return nil
 }
+
+// configPreviewOutput is the document printed by config preview with --output json|yaml.
+// Unset settings are empty rather than omitted; API tokens are never printed.
+type configPreviewOutput struct {
+	ConfigFile            string                       `json:"config_file"`
+	UserName              string                       `json:"user_name"`
+	UserEmail             string                       `json:"user_email"`
+	GChatReviewWebhookURL string                       `json:"gchat_review_webhook_url"`
+	GChatCollabWebhookURL string                       `json:"gchat_collab_webhook_url"`
+	GChatMessageFormat    string                       `json:"gchat_message_format"`
+	PreferredEditor       string                       `json:"preferred_editor"`
+	ProjectRoot           string                       `json:"project_root"`
+	Channels              []channelOutput              `json:"channels"`
+	Stages                []stageOutput                `json:"stages"`
+	Routes                []config.Route               `json:"routes"`
+	Team                  []config.TeamMember          `json:"team"`
+	ReviewSLA             map[string]string            `json:"review_sla"`
+	ReminderCooldown      string                       `json:"reminder_cooldown"`
+	JiraBaseURL           string                       `json:"jira_base_url"`
+	PRHosts               []string                     `json:"pr_hosts"`
+	GitHubTokenSet        bool                         `json:"github_token_set"`
+	GitHubAPIURL          string                       `json:"github_api_url"`
+	GitLabTokenSet        bool                         `json:"gitlab_token_set"`
+	GitLabAPIURL          string                       `json:"gitlab_api_url"`
+	JiraUser              string                       `json:"jira_user"`
+	JiraTokenSet          bool                         `json:"jira_token_set"`
+	JiraActions           map[string]config.JiraAction `json:"jira_actions"`
+}
+
+// channelOutput is a notification channel without its secrets: webhook URLs carry their
+// auth token, so only the host is printed, and header values are masked
+type channelOutput struct {
+	Name    string            `json:"name"`
+	Type    string            `json:"type"`
+	Host    string            `json:"host"`
+	Format  string            `json:"format,omitempty"`
+	Headers map[string]string `json:"headers,omitempty"`
+}
+
+func newChannelOutput(ch config.Channel) channelOutput {
+	out := channelOutput{
+		Name:   ch.Name,
+		Type:   ch.Type,
+		Host:   webhookHost(ch.WebhookURL),
+		Format: ch.Format,
+	}
+	if len(ch.Headers) > 0 {
+		out.Headers = make(map[string]string, len(ch.Headers))
+		for name := range ch.Headers {
+			out.Headers[name] = "***"
+		}
+	}
+	return out
+}
+
+// webhookHost returns the host of a webhook URL, leaving out the path and query that
+// hold its token
+func webhookHost(webhookURL string) string {
+	u, err := url.Parse(webhookURL)
+	if err != nil || u.Host == "" {
+		return "(invalid URL)"
+	}
+	return u.Host
+}
+
+// stageOutput is the channels a review stage notifies, or why it cannot notify any
+type stageOutput struct {
+	Stage    string   `json:"stage"`
+	Channels []string `json:"channels"`
+	Error    string   `json:"error,omitempty"`
+}
+
+func newConfigPreviewOutput(cfg *config.Config) configPreviewOutput {
+	jiraUser, jiraToken := cfg.JiraCredentials()
+	doc := configPreviewOutput{
+		ConfigFile:            "~/.cool-cli/config.json",
+		UserName:              cfg.UserName,
+		UserEmail:             cfg.UserEmail,
+		GChatReviewWebhookURL: cfg.GChatReviewWebhookURL,
+		GChatCollabWebhookURL: cfg.GChatCollabWebhookURL,
+		GChatMessageFormat:    cfg.GChatMessageFormat,
+		PreferredEditor:       cfg.PreferredEditor,
+		ProjectRoot:           cfg.ProjectRoot,
+		Channels:              []channelOutput{},
+		Routes:                cfg.Routes,
+		Team:                  cfg.Team,
+		ReviewSLA:             cfg.ReviewSLA,
+		ReminderCooldown:      cfg.ReminderCooldown,
+		JiraBaseURL:           cfg.JiraBaseURL,
+		PRHosts:               cfg.KnownPRHosts(),
+		GitHubTokenSet:        cfg.CodeHostToken(config.CodeHostGitHub) != "",
+		GitHubAPIURL:          cfg.GitHubAPIURL,
+		GitLabTokenSet:        cfg.CodeHostToken(config.CodeHostGitLab) != "",
+		GitLabAPIURL:          cfg.GitLabAPIURL,
+		JiraUser:              jiraUser,
+		JiraTokenSet:          jiraToken != "",
+		JiraActions:           cfg.JiraActions,
+	}
+
+	if doc.GChatMessageFormat == "" {
+		doc.GChatMessageFormat = config.MessageFormatCards
+	}
+	for _, ch := range cfg.Channels {
+		doc.Channels = append(doc.Channels, newChannelOutput(ch))
+	}
+	if doc.Routes == nil {
+		doc.Routes = []config.Route{}
+	}
+	if doc.Team == nil {
+		doc.Team = []config.TeamMember{}
+	}
+	if doc.ReviewSLA == nil {
+		doc.ReviewSLA = map[string]string{}
+	}
+	if doc.JiraActions == nil {
+		doc.JiraActions = map[string]config.JiraAction{}
+	}
+
+	for _, stage := range []string{config.StageReview, config.StageCollab} {
+		out := stageOutput{Stage: stage, Channels: []string{}}
+		channels, err := cfg.StageChannels(stage)
+		if err != nil {
+			out.Error = err.Error()
+		}
+		for _, ch := range channels {
+			out.Channels = append(out.Channels, ch.Name)
+		}
+		doc.Stages = append(doc.Stages, out)
+	}
+	return doc
+}
+
+// formatRouteConditions describes what a route matches, e.g. "repositories org/* · priorities P0"
+func formatRouteConditions(route config.Route) string {
+	var conditions []string
+	for _, condition := range []struct {
+		label  string
+		values []string
+	}{
+		{"repositories", route.Repositories},
+		{"Jira projects", route.JiraProjects},
+		{"priorities", route.Priorities},
+		{"project dirs", route.ProjectDirs},
+	} {
+		if len(condition.values) > 0 {
+			conditions = append(conditions, condition.label+" "+strings.Join(condition.values, ", "))
+		}
+	}
+	if len(conditions) == 0 {
+		return "every request"
+	}
+	return strings.Join(conditions, " · ")
+}
@@ -0,0 +1,78 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/yatbfi/cool/internal/domain/entity"
+	"github.com/yatbfi/cool/internal/domain/usecase"
+	"github.com/yatbfi/cool/internal/pkg/common"
+)
+
+// OutboxCmd is the parent command for notification outbox operations
+type OutboxCmd struct {
+	*baseCmd
+}
+
+// NewOutboxCmd creates a new outbox command
+func NewOutboxCmd() *OutboxCmd {
+	cmd := &OutboxCmd{}
+	cmd.baseCmd = newBaseCommand(&cobra.Command{
+		Use:   "outbox",
+		Short: "Inspect and retry queued chat notifications",
+		Long: `Inspect and retry chat notifications that could not be delivered.
+
+Every notification is queued in ~/.cool-cli/outbox.json before it is sent.
+When a webhook fails, the review change is still saved and the notification
+stays in the outbox. It is retried with exponential backoff (honouring the
+Retry-After header) and gives up after repeated failures.
+
+This command provides subcommands to:
+- List undelivered notifications and their status
+- Flush (retry) notifications that are due, or all of them
+- Drop notifications that should not be sent anymore`,
+	})
+	return cmd
+}
+
+// formatOutboxStatus returns a display label for an outbox message status
+func formatOutboxStatus(status entity.OutboxStatus) string {
+	labels := map[entity.OutboxStatus]string{
+		entity.OutboxStatusPending: "⏳ Pending",
+		entity.OutboxStatusSent:    "✅ Sent",
+		entity.OutboxStatusFailed:  "❌ Failed",
+	}
+
+	if label, ok := labels[status]; ok {
+		return label
+	}
+	return string(status)
+}
+
+// formatNextAttempt describes when a message will be retried
+func formatNextAttempt(m *usecase.OutboxMessage, now time.Time) string {
+	if m.Status != entity.OutboxStatusPending {
+		return "-"
+	}
+	if m.NextAttemptAt == nil || !now.Before(*m.NextAttemptAt) {
+		return "now"
+	}
+	return "in " + common.FormatDuration(m.NextAttemptAt.Sub(now))
+}
+
+// printUndeliveredNotifications warns about notifications of a review request left in the outbox
+func printUndeliveredNotifications(ctx context.Context, reviewUc usecase.Review, historyID string) {
+	messages, err := reviewUc.UndeliveredNotifications(ctx, historyID)
+	if err != nil || len(messages) == 0 {
+		return
+	}
+
+	fmt.Printf("⚠️  %d notification(s) could not be delivered and were queued for retry:\n", len(messages))
+	for _, m := range messages {
+		fmt.Printf("   • %s: %s\n", m.Channel, m.LastError)
+	}
+	fmt.Println("   Run 'cool outbox flush' to retry now or 'cool outbox list' for details.")
+	fmt.Println()
+}
@@ -0,0 +1,44 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"github.com/yatbfi/cool/internal/domain/usecase"
+)
+
+// OutboxDropCmd discards queued notifications
+type OutboxDropCmd struct {
+	*baseCmd
+	outboxUc usecase.Outbox
+}
+
+// NewOutboxDropCmd creates a new outbox drop command
+func NewOutboxDropCmd(outboxUc usecase.Outbox) *OutboxDropCmd {
+	cmd := &OutboxDropCmd{
+		outboxUc: outboxUc,
+	}
+	cmd.baseCmd = newBaseCommand(&cobra.Command{
+		Use:   "drop <id>...",
+		Short: "Discard queued notifications without sending them",
+		Long: `Remove notifications from the outbox so they are never sent.
+
+Examples:
+  cool outbox drop abc123
+  cool outbox drop abc123 def456`,
+		Args: cobra.MinimumNArgs(1),
+		RunE: cmd.run,
+	})
+	return cmd
+}
+
+func (c *OutboxDropCmd) run(cmd *cobra.Command, args []string) error {
+	for _, id := range args {
+		m, err := c.outboxUc.Drop(cmd.Context(), id)
+		if err != nil {
+			return err
+		}
+		fmt.Printf("🗑️  Dropped %s notification %s for review %s (%s)\n", m.Kind, m.ID, m.EntryID, m.Channel)
+	}
+	return nil
+}
@@ -0,0 +1,81 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"github.com/yatbfi/cool/internal/domain/entity"
+	"github.com/yatbfi/cool/internal/domain/usecase"
+)
+
+// OutboxFlushCmd retries queued notifications
+type OutboxFlushCmd struct {
+	*baseCmd
+	outboxUc usecase.Outbox
+	all      bool
+}
+
+// NewOutboxFlushCmd creates a new outbox flush command
+func NewOutboxFlushCmd(outboxUc usecase.Outbox) *OutboxFlushCmd {
+	cmd := &OutboxFlushCmd{
+		outboxUc: outboxUc,
+	}
+	cmd.baseCmd = newBaseCommand(&cobra.Command{
+		Use:   "flush",
+		Short: "Retry undelivered notifications",
+		Long: `Retry notifications in the outbox.
+
+By default only pending notifications whose backoff has elapsed are sent.
+Use --all to retry every undelivered notification right away, including
+ones that were given up on (e.g. after fixing a webhook URL).
+
+Notifications for the same review and channel are always sent in order.
+
+Examples:
+  cool outbox flush         Retry notifications that are due
+  cool outbox flush --all   Retry everything now`,
+		RunE: cmd.run,
+	})
+	cmd.initFlags()
+	return cmd
+}
+
+func (c *OutboxFlushCmd) run(cmd *cobra.Command, _ []string) error {
+	attempted, err := c.outboxUc.Flush(cmd.Context(), c.all)
+	if err != nil {
+		return err
+	}
+
+	if len(attempted) == 0 {
+		fmt.Println()
+		fmt.Println("📭 No notifications are due for retry")
+		if !c.all {
+			fmt.Println("   Use --all to retry waiting notifications now.")
+		}
+		fmt.Println()
+		return nil
+	}
+
+	fmt.Println()
+	sent := 0
+	for _, m := range attempted {
+		switch m.Status {
+		case entity.OutboxStatusSent:
+			sent++
+			fmt.Printf("✅ %s → %s: delivered\n", m.EntryID, m.Channel)
+		case entity.OutboxStatusFailed:
+			fmt.Printf("❌ %s → %s: gave up after %d attempt(s): %s\n", m.EntryID, m.Channel, m.Attempts, m.LastError)
+		default:
+			fmt.Printf("⏳ %s → %s: %s (retry %s)\n", m.EntryID, m.Channel, m.LastError, formatNextAttempt(m, *m.LastAttemptAt))
+		}
+	}
+	fmt.Println()
+	fmt.Printf("Delivered %d of %d notification(s)\n", sent, len(attempted))
+	fmt.Println()
+
+	return nil
+}
+
+func (c *OutboxFlushCmd) initFlags() {
+	c.cmd.Flags().BoolVar(&c.all, "all", false, "Retry all undelivered notifications, ignoring backoff")
+}
@@ -0,0 +1,106 @@
+package cmd
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/yatbfi/cool/internal/domain/usecase"
+	"github.com/yatbfi/cool/internal/pkg/table"
+)
+
+// OutboxListCmd lists queued notifications
+type OutboxListCmd struct {
+	*baseCmd
+	outboxUc usecase.Outbox
+	all      bool
+}
+
+// NewOutboxListCmd creates a new outbox list command
+func NewOutboxListCmd(outboxUc usecase.Outbox) *OutboxListCmd {
+	cmd := &OutboxListCmd{
+		outboxUc: outboxUc,
+	}
+	cmd.baseCmd = newBaseCommand(&cobra.Command{
+		Use:   "list",
+		Short: "List undelivered notifications",
+		Long: `List notifications waiting in the outbox with their delivery status,
+number of attempts, last error and next retry.
+
+Examples:
+  cool outbox list          List pending and failed notifications
+  cool outbox list --all    Include recently delivered notifications
+  cool outbox list -o json  Print the outbox as JSON`,
+		Annotations: outputFormats(outputJSON, outputYAML),
+		RunE:        cmd.run,
+	})
+	cmd.initFlags()
+	return cmd
+}
+
+// outboxListOutput is the document printed by outbox list with --output json|yaml
+type outboxListOutput struct {
+	Total    int                      `json:"total"`
+	Messages []*usecase.OutboxMessage `json:"messages"`
+}
+
+func (c *OutboxListCmd) run(cmd *cobra.Command, _ []string) error {
+	format, err := outputFormat(cmd)
+	if err != nil {
+		return err
+	}
+
+	messages, err := c.outboxUc.List(cmd.Context(), c.all)
+	if err != nil {
+		return err
+	}
+
+	if isStructuredOutput(format) {
+		if messages == nil {
+			messages = []*usecase.OutboxMessage{}
+		}
+		return printStructured(format, outboxListOutput{Total: len(messages), Messages: messages})
+	}
+
+	if len(messages) == 0 {
+		fmt.Println()
+		fmt.Println("📭 Outbox is empty, all notifications were delivered")
+		fmt.Println()
+		return nil
+	}
+
+	now := time.Now()
+	tbl := table.NewTable("ID", "Review", "Channel", "Kind", "Status", "Attempts", "Next Attempt", "Last Error")
+	for _, m := range messages {
+		lastError := m.LastError
+		if len(lastError) > 50 {
+			lastError = lastError[:47] + "..."
+		}
+		if lastError == "" {
+			lastError = "-"
+		}
+
+		tbl.AddRow(
+			m.ID,
+			m.EntryID,
+			m.Channel,
+			m.Kind,
+			formatOutboxStatus(m.Status),
+			fmt.Sprintf("%d", m.Attempts),
+			formatNextAttempt(m, now),
+			lastError,
+		)
+	}
+
+	tbl.Print()
+	fmt.Printf("Total: %d notification(s)\n", tbl.RowCount())
+	fmt.Println()
+	fmt.Println("💡 To retry: cool outbox flush   To discard: cool outbox drop <id>")
+	fmt.Println()
+
+	return nil
+}
+
+func (c *OutboxListCmd) initFlags() {
+	c.cmd.Flags().BoolVar(&c.all, "all", false, "Include delivered notifications")
+}
@@ -0,0 +1,201 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/yatbfi/cool/internal/domain/usecase"
+	"gopkg.in/yaml.v3"
+)
+
+// Output formats selected with the global --output flag. The default is the
+// human-readable text; json and yaml print stable documents for scripts and
+// wide prints tables with full IDs and untruncated titles.
+const (
+	outputText = ""
+	outputJSON = "json"
+	outputYAML = "yaml"
+	outputWide = "wide"
+)
+
+// outputFormatsAnnotation lists the --output formats a command supports, comma-separated
+const outputFormatsAnnotation = "output-formats"
+
+// outputFormats returns the command annotations declaring the supported --output formats
+func outputFormats(formats ...string) map[string]string {
+	return map[string]string{outputFormatsAnnotation: strings.Join(formats, ",")}
+}
+
+// outputFormat returns the validated --output flag of a command. Commands declare the
+// formats they support with outputFormats; any other format is an error rather than
+// silently printing text.
+func outputFormat(cmd *cobra.Command) (string, error) {
+	format, err := cmd.Flags().GetString("output")
+	if err != nil || format == outputText {
+		return outputText, nil
+	}
+
+	switch format {
+	case outputJSON, outputYAML, outputWide:
+	default:
+		return "", fmt.Errorf("invalid output format %q (must be json, yaml or wide)", format)
+	}
+
+	supported := strings.Split(cmd.Annotations[outputFormatsAnnotation], ",")
+	if !slices.Contains(supported, format) {
+		if supported[0] == "" {
+			return "", fmt.Errorf("unsupported output format %q: '%s' only prints text", format, cmd.CommandPath())
+		}
+		return "", fmt.Errorf("unsupported output format %q: '%s' supports %s", format, cmd.CommandPath(), strings.Join(supported, ", "))
+	}
+	return format, nil
+}
+
+// isStructuredOutput reports whether a format prints a document instead of text
+func isStructuredOutput(format string) bool {
+	return format == outputJSON || format == outputYAML
+}
+
+// printStructured writes v to stdout as JSON or YAML. YAML uses the same keys as
+// the JSON document.
+func printStructured(format string, v interface{}) error {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return fmt.Errorf("encode output: %w", err)
+	}
+
+	if format == outputJSON {
+		encoder := json.NewEncoder(os.Stdout)
+		encoder.SetIndent("", "  ")
+		return encoder.Encode(json.RawMessage(data))
+	}
+
+	// JSON is valid YAML; re-encoding its node tree keeps the key order
+	var node yaml.Node
+	if err := yaml.Unmarshal(data, &node); err != nil {
+		return fmt.Errorf("encode output: %w", err)
+	}
+	resetYAMLStyle(&node)
+
+	encoder := yaml.NewEncoder(os.Stdout)
+	encoder.SetIndent(2)
+	if err := encoder.Encode(&node); err != nil {
+		return fmt.Errorf("encode output: %w", err)
+	}
+	return encoder.Close()
+}
+
+// resetYAMLStyle switches parsed JSON from flow style to block style
+func resetYAMLStyle(node *yaml.Node) {
+	node.Style = 0
+	for _, child := range node.Content {
+		resetYAMLStyle(child)
+	}
+}
+
+// reviewListOutput is the document printed by review listings with --output json|yaml
+type reviewListOutput struct {
+	Total   int                `json:"total"`
+	Reviews []reviewOutputItem `json:"reviews"`
+}
+
+// reviewOutputItem is a review request in machine-readable listings
+type reviewOutputItem struct {
+	ID                  string                    `json:"id"`
+	ShortID             string                    `json:"short_id"`
+	Title               string                    `json:"title"`
+	Description         string                    `json:"description"`
+	Priority            string                    `json:"priority"`
+	Type                string                    `json:"type"`
+	Status              string                    `json:"status"`
+	ReviewLinks         []string                  `json:"review_links"`
+	PullRequests        []usecase.PullRequestInfo `json:"pull_requests"`
+	JiraLinks           []string                  `json:"jira_links"`
+	JiraIssues          []usecase.JiraIssueInfo   `json:"jira_issues"`
+	ProjectDir          string                    `json:"project_dir"`
+	SubmittedBy         string                    `json:"submitted_by"`
+	SubmittedByEmail    string                    `json:"submitted_by_email"`
+	SubmittedAt         time.Time                 `json:"submitted_at"`
+	UpdatedAt           *time.Time                `json:"updated_at"`
+	ApprovedByTechLead  bool                      `json:"approved_by_tech_lead"`
+	ApprovedByArchitect bool                      `json:"approved_by_architect"`
+	SubmittedToCollab   bool                      `json:"submitted_to_collab"`
+	SubmittedToCollabAt *time.Time                `json:"submitted_to_collab_at"`
+	SubmittedToCollabBy string                    `json:"submitted_to_collab_by"`
+}
+
+// printReviewList writes review requests as a JSON or YAML document
+func printReviewList(format string, entries []*usecase.ReviewHistoryEntry) error {
+	doc := reviewListOutput{
+		Total:   len(entries),
+		Reviews: make([]reviewOutputItem, 0, len(entries)),
+	}
+	for _, entry := range entries {
+		doc.Reviews = append(doc.Reviews, newReviewOutputItem(entry))
+	}
+	return printStructured(format, doc)
+}
+
+// newReviewOutputItem converts a review request for machine-readable output
+func newReviewOutputItem(entry *usecase.ReviewHistoryEntry) reviewOutputItem {
+	return reviewOutputItem{
+		ID:                  entry.ID,
+		ShortID:             usecase.ShortID(entry.ID),
+		Title:               entry.Title,
+		Description:         entry.Description,
+		Priority:            entry.Priority,
+		Type:                entry.Type,
+		Status:              string(entry.CurrentStatus()),
+		ReviewLinks:         nonNilStrings(entry.ReviewLinks),
+		PullRequests:        nonNilPullRequests(entry.PullRequests),
+		JiraLinks:           nonNilStrings(entry.JiraLinks),
+		JiraIssues:          nonNilJiraIssues(entry.JiraIssues),
+		ProjectDir:          entry.ProjectDir,
+		SubmittedBy:         entry.SubmittedBy,
+		SubmittedByEmail:    entry.SubmittedByEmail,
+		SubmittedAt:         entry.SubmittedAt,
+		UpdatedAt:           entry.UpdatedAt,
+		ApprovedByTechLead:  entry.ApprovedByTechLead,
+		ApprovedByArchitect: entry.ApprovedByArchitect,
+		SubmittedToCollab:   entry.SubmittedToCollab,
+		SubmittedToCollabAt: entry.SubmittedToCollabAt,
+		SubmittedToCollabBy: entry.SubmittedToCollabBy,
+	}
+}
+
+// nonNilStrings keeps empty lists as [] rather than null in documents
+func nonNilStrings(values []string) []string {
+	if values == nil {
+		return []string{}
+	}
+	return values
+}
+
+// nonNilPullRequests keeps missing pull request details as [] rather than null in documents
+func nonNilPullRequests(values []usecase.PullRequestInfo) []usecase.PullRequestInfo {
+	if values == nil {
+		return []usecase.PullRequestInfo{}
+	}
+	return values
+}
+
+// nonNilJiraIssues keeps missing Jira details as [] rather than null in documents
+func nonNilJiraIssues(values []usecase.JiraIssueInfo) []usecase.JiraIssueInfo {
+	if values == nil {
+		return []usecase.JiraIssueInfo{}
+	}
+	return values
+}
+
+// truncateTitle shortens long titles for tables, unless the wide output is selected
+func truncateTitle(title, format string) string {
+	if format != outputWide && len(title) > 40 {
+		return title[:37] + "..."
+	}
+	return title
+}
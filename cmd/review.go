@@ -1,7 +1,12 @@
 package cmd
 
 import (
+	"fmt"
+	"strings"
+
 	"github.com/spf13/cobra"
+	"github.com/yatbfi/cool/internal/domain/entity"
+	"github.com/yatbfi/cool/internal/domain/usecase"
 )
 
 // ReviewCmd is the parent command for review operations
@@ -19,8 +24,113 @@ func NewReviewCmd() *ReviewCmd {
 
 This command provides subcommands to:
 - Submit review requests to tech lead
-- View review history
-- Submit approved reviews to collaboration channel`,
+- View review history and per-request timelines
+- Track approvals (approve, reject, withdraw)
+- Submit approved reviews to collaboration channel
+
+Review IDs can be abbreviated to any unique prefix of at least 4 characters,
+like git short SHAs (e.g. the 8-character IDs shown by --list).
+
+Review lifecycle:
+  submitted -> tech-lead-approved -> forwarded -> architect-approved -> merged
+  (changes-requested and withdrawn can be reached from any open stage; 'cool review
+  sync' marks requests merged or closed once all their pull requests are)`,
 	})
 	return cmd
 }
+
+// formatReviewStatus returns a display label for a review lifecycle status
+func formatReviewStatus(status usecase.ReviewStatus) string {
+	labels := map[usecase.ReviewStatus]string{
+		entity.ReviewStatusDraft:             "📝 Draft",
+		entity.ReviewStatusSubmitted:         "⏳ Submitted",
+		entity.ReviewStatusChangesRequested:  "✏️ Changes Requested",
+		entity.ReviewStatusTechLeadApproved:  "👍 TL Approved",
+		entity.ReviewStatusForwarded:         "📨 Forwarded",
+		entity.ReviewStatusArchitectApproved: "✅ Architect Approved",
+		entity.ReviewStatusMerged:            "🎉 Merged",
+		entity.ReviewStatusClosed:            "🚫 Closed",
+		entity.ReviewStatusWithdrawn:         "↩️ Withdrawn",
+	}
+
+	if label, ok := labels[status]; ok {
+		return label
+	}
+	return string(status)
+}
+
+// printReviewStatusChange prints the outcome of a lifecycle transition
+func printReviewStatusChange(entry *usecase.ReviewHistoryEntry) {
+	fmt.Println()
+	fmt.Printf("✅ Review %s is now %s\n", entry.ID, formatReviewStatus(entry.CurrentStatus()))
+	fmt.Printf("   Title: %s\n", entry.Title)
+	fmt.Println()
+}
+
+// printReviewDetails prints the stored fields of a review request
+func printReviewDetails(entry *usecase.ReviewHistoryEntry) {
+	fmt.Println()
+	fmt.Println("📋 Review Request Details")
+	fmt.Println("=========================")
+	fmt.Println()
+	fmt.Printf("ID: %s\n", entry.ID)
+	fmt.Printf("Title: %s\n", entry.Title)
+	fmt.Printf("Priority: %s\n", entry.Priority)
+	if entry.Type != "" {
+		fmt.Printf("Type: %s\n", usecase.RequestTypeLabel(entry.Type))
+	}
+	fmt.Printf("Status: %s\n", formatReviewStatus(entry.CurrentStatus()))
+	if len(entry.Revisions) > 0 {
+		fmt.Printf("Revision: %d\n", entry.CurrentRevision())
+	}
+	fmt.Printf("Description: %s\n", entry.Description)
+	fmt.Println()
+	fmt.Printf("Submitted by: %s (%s)\n", entry.SubmittedBy, entry.SubmittedByEmail)
+	fmt.Printf("Submitted at: %s\n", entry.SubmittedAt.Format("2006-01-02 15:04:05"))
+	if entry.ProjectDir != "" {
+		fmt.Printf("Project dir: %s\n", entry.ProjectDir)
+	}
+	if match := usecase.MatchRoute(entry); match != nil {
+		fmt.Printf("Route: %s (%s)\n", match.Route.Name, match.Reason)
+	}
+	fmt.Println()
+
+	if len(entry.ReviewLinks) > 0 {
+		fmt.Println("Pull Requests:")
+		for _, link := range entry.ReviewLinks {
+			fmt.Printf("  • %s\n", link)
+			if pr := entry.PullRequest(link); pr != nil {
+				fmt.Printf("    %s (as of %s)\n", pr.Summary(), pr.FetchedAt.Format("2006-01-02 15:04"))
+			}
+		}
+		fmt.Println()
+	}
+
+	if len(entry.JiraLinks) > 0 {
+		fmt.Println("Jira Tickets:")
+		for _, link := range entry.JiraLinks {
+			fmt.Printf("  • %s\n", link)
+			if issue := entry.JiraIssue(link); issue != nil {
+				fmt.Printf("    %s (as of %s)\n", issue.Details(), issue.FetchedAt.Format("2006-01-02 15:04"))
+			}
+		}
+		fmt.Println()
+	}
+}
+
+// printJiraUpdates prints the Jira transitions and comments made by the latest action, if any
+func printJiraUpdates(entry *usecase.ReviewHistoryEntry) {
+	if len(entry.Events) == 0 {
+		return
+	}
+	event := entry.Events[len(entry.Events)-1]
+	if event.Type != entity.ReviewEventJira {
+		return
+	}
+
+	fmt.Println("🎫 Jira updated:")
+	for _, line := range strings.Split(event.Note, "\n") {
+		fmt.Printf("   %s\n", line)
+	}
+	fmt.Println()
+}
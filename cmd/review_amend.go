@@ -0,0 +1,117 @@
+package cmd
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/yatbfi/cool/internal/domain/usecase"
+)
+
+// ReviewAmendCmd edits an already-submitted review request
+type ReviewAmendCmd struct {
+	*baseCmd
+	reviewUc usecase.Review
+}
+
+// NewReviewAmendCmd creates a new review amend command
+func NewReviewAmendCmd(reviewUc usecase.Review) *ReviewAmendCmd {
+	cmd := &ReviewAmendCmd{
+		reviewUc: reviewUc,
+	}
+	cmd.baseCmd = newBaseCommand(&cobra.Command{
+		Use:   "amend <review-id>",
+		Short: "Amend a submitted review request",
+		Long: `Amend a review request that was already submitted, e.g. to add more pull
+requests or fix a Jira link.
+
+The previous version is kept as a revision and an "updated request" message
+highlighting what changed is posted to the review channel (and to the
+collaboration channel if the request was already forwarded). Amending a request
+with changes requested puts it back to submitted.
+
+Examples:
+  cool review amend abc123`,
+		Args: cobra.ExactArgs(1),
+		RunE: cmd.run,
+	})
+	return cmd
+}
+
+func (c *ReviewAmendCmd) run(cmd *cobra.Command, args []string) error {
+	ctx := cmd.Context()
+
+	entry, err := c.reviewUc.GetHistoryByID(ctx, args[0])
+	if err != nil {
+		return fmt.Errorf("get review: %w", err)
+	}
+	if err := usecase.ValidateAmend(entry); err != nil {
+		return err
+	}
+
+	fmt.Println()
+	fmt.Printf("✏️  Amend Review Request (revision %d)\n", entry.CurrentRevision())
+	fmt.Println("==================================")
+
+	req := usecase.ReviewRequestFromEntry(entry)
+	for {
+		editReq, err := editReviewRequest(req)
+		if err != nil {
+			return err
+		}
+		req = editReq
+
+		fmt.Println()
+		fmt.Println("📋 Preview Updated Request")
+		fmt.Println("==========================")
+
+		_, message, err := c.reviewUc.AmendReviewRequest(ctx, entry.ID, req, false)
+		if err != nil {
+			fmt.Printf("❌ %s\n", err.Error())
+		} else {
+			fmt.Println()
+			fmt.Println(message)
+		}
+		fmt.Println()
+		printMissingSections(req)
+
+		fmt.Print("Do you want to (s)ubmit, (e)dit, or (c)ancel? [s/e/c]: ")
+		var action string
+		_, _ = fmt.Scanln(&action)
+		action = strings.ToLower(strings.TrimSpace(action))
+
+		switch action {
+		case "s", "submit", "":
+			if err != nil {
+				fmt.Println("❌ Nothing to submit yet. Please edit the request first.")
+				continue
+			}
+
+			fmt.Println()
+			fmt.Println("⏳ Submitting amendment...")
+			amended, _, err := c.reviewUc.AmendReviewRequest(ctx, entry.ID, req, true)
+			if err != nil {
+				return fmt.Errorf("amend review request: %w", err)
+			}
+
+			fmt.Println()
+			fmt.Println("✅ Review request updated!")
+			fmt.Printf("   Request ID: %s\n", amended.ID)
+			fmt.Printf("   Revision: %d\n", amended.CurrentRevision())
+			fmt.Printf("   Status: %s\n", formatReviewStatus(amended.CurrentStatus()))
+			fmt.Println()
+			printUndeliveredNotifications(ctx, c.reviewUc, amended.ID)
+			return nil
+
+		case "e", "edit":
+			// Continue loop to edit again
+
+		case "c", "cancel":
+			fmt.Println("\n❌ Amendment cancelled")
+			return nil
+
+		default:
+			fmt.Println("❌ Invalid option. Please choose (s)ubmit, (e)dit, or (c)ancel.")
+		}
+	}
+}
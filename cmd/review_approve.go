@@ -0,0 +1,58 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"github.com/yatbfi/cool/internal/domain/entity"
+	"github.com/yatbfi/cool/internal/domain/usecase"
+)
+
+// ReviewApproveCmd records an approval for a review request
+type ReviewApproveCmd struct {
+	*baseCmd
+	reviewUc usecase.Review
+	note     string
+}
+
+// NewReviewApproveCmd creates a new review approve command
+func NewReviewApproveCmd(reviewUc usecase.Review) *ReviewApproveCmd {
+	cmd := &ReviewApproveCmd{
+		reviewUc: reviewUc,
+	}
+	cmd.baseCmd = newBaseCommand(&cobra.Command{
+		Use:   "approve <review-id>",
+		Short: "Record approval of a review request",
+		Long: `Record an approval of a review request.
+
+A submitted review becomes tech-lead-approved and can then be forwarded with
+'cool review submit-collab'. A forwarded review becomes architect-approved.
+
+Examples:
+  cool review approve abc123
+  cool review approve abc123 --note "LGTM from Jane"`,
+		Args: cobra.ExactArgs(1),
+		RunE: cmd.run,
+	})
+	cmd.initFlags()
+	return cmd
+}
+
+func (c *ReviewApproveCmd) run(cmd *cobra.Command, args []string) error {
+	entry, err := c.reviewUc.ApproveReview(cmd.Context(), args[0], c.note)
+	if err != nil {
+		return fmt.Errorf("approve review: %w", err)
+	}
+
+	printReviewStatusChange(entry)
+	if entry.CurrentStatus() == entity.ReviewStatusTechLeadApproved {
+		fmt.Println("💡 Forward it to head architect using:")
+		fmt.Printf("   cool review submit-collab %s\n", entry.ID)
+		fmt.Println()
+	}
+	return nil
+}
+
+func (c *ReviewApproveCmd) initFlags() {
+	c.cmd.Flags().StringVar(&c.note, "note", "", "Optional approval note")
+}
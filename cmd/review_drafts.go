@@ -0,0 +1,110 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"github.com/yatbfi/cool/internal/domain/usecase"
+	"github.com/yatbfi/cool/internal/pkg/table"
+)
+
+// ReviewDraftsCmd lists and discards saved review request drafts
+type ReviewDraftsCmd struct {
+	*baseCmd
+	reviewUc usecase.Review
+	deleteID string
+}
+
+// NewReviewDraftsCmd creates a new review drafts command
+func NewReviewDraftsCmd(reviewUc usecase.Review) *ReviewDraftsCmd {
+	cmd := &ReviewDraftsCmd{
+		reviewUc: reviewUc,
+	}
+	cmd.baseCmd = newBaseCommand(&cobra.Command{
+		Use:   "drafts",
+		Short: "List saved review request drafts",
+		Long: `List review requests that were saved as drafts and not submitted yet.
+
+Drafts are created automatically while you fill in 'cool review request'.
+
+Examples:
+  cool review drafts                    List drafts
+  cool review request --resume abc123   Continue editing and submit a draft
+  cool review drafts --delete abc123    Discard a draft
+  cool review drafts -o json            Print drafts as JSON`,
+		Annotations: outputFormats(outputJSON, outputYAML),
+		RunE:        cmd.run,
+	})
+	cmd.initFlags()
+	return cmd
+}
+
+func (c *ReviewDraftsCmd) run(cmd *cobra.Command, _ []string) error {
+	ctx := cmd.Context()
+
+	format, err := outputFormat(cmd)
+	if err != nil {
+		return err
+	}
+
+	if c.deleteID != "" {
+		if format != outputText {
+			return fmt.Errorf("--output cannot be used with --delete")
+		}
+		if err := c.reviewUc.DeleteDraft(ctx, c.deleteID); err != nil {
+			return err
+		}
+		fmt.Printf("🗑️  Draft %s discarded\n", c.deleteID)
+		return nil
+	}
+
+	drafts, err := c.reviewUc.GetHistories(ctx, usecase.HistoryFilterDrafts)
+	if err != nil {
+		return fmt.Errorf("get drafts: %w", err)
+	}
+	if isStructuredOutput(format) {
+		return printReviewList(format, drafts)
+	}
+
+	if len(drafts) == 0 {
+		fmt.Println()
+		fmt.Println("📝 No drafts found")
+		fmt.Println()
+		return nil
+	}
+
+	tbl := table.NewTable("ID", "Title", "Priority", "PRs", "Jira", "Created", "Last Saved")
+	for _, entry := range drafts {
+		title := entry.Title
+		if len(title) > 40 {
+			title = title[:37] + "..."
+		}
+
+		lastSaved := "-"
+		if entry.UpdatedAt != nil {
+			lastSaved = entry.UpdatedAt.Format("2006-01-02 15:04")
+		}
+
+		tbl.AddRow(
+			entry.ID,
+			title,
+			entry.Priority,
+			fmt.Sprintf("%d", len(entry.ReviewLinks)),
+			fmt.Sprintf("%d", len(entry.JiraLinks)),
+			entry.SubmittedAt.Format("2006-01-02 15:04"),
+			lastSaved,
+		)
+	}
+
+	tbl.Print()
+	fmt.Printf("Total: %d draft(s)\n", tbl.RowCount())
+	fmt.Println()
+	fmt.Println("💡 To continue a draft: cool review request --resume <id>")
+	fmt.Println()
+
+	return nil
+}
+
+func (c *ReviewDraftsCmd) initFlags() {
+	c.cmd.Flags().StringVar(&c.deleteID, "delete", "", "Discard the draft with this ID")
+}
@@ -0,0 +1,105 @@
+package cmd
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/yatbfi/cool/internal/domain/usecase"
+)
+
+// ReviewExportCmd exports review request history
+type ReviewExportCmd struct {
+	*baseCmd
+	reviewUc usecase.Review
+	format   string
+	since    string
+	until    string
+	file     string
+}
+
+// NewReviewExportCmd creates a new review export command
+func NewReviewExportCmd(reviewUc usecase.Review) *ReviewExportCmd {
+	cmd := &ReviewExportCmd{
+		reviewUc: reviewUc,
+	}
+	cmd.baseCmd = newBaseCommand(&cobra.Command{
+		Use:   "export",
+		Short: "Export review request history",
+		Long: `Export review requests with all their links and collaboration status.
+
+Formats:
+  csv   one row per request, multiple links separated by newlines (default)
+  json  full history entries, including events
+  md    Markdown table, e.g. for a sprint report
+  html  standalone HTML page
+
+The export is written to stdout unless --file is given. --since and --until
+accept the same values as 'cool review stats'.
+
+Examples:
+  cool review export > reviews.csv
+  cool review export --format md --since 14d
+  cool review export --format html --since 2025-10-01 --until 2025-10-31 --file october.html`,
+		RunE: cmd.run,
+	})
+	cmd.initFlags()
+	return cmd
+}
+
+func (c *ReviewExportCmd) run(cmd *cobra.Command, _ []string) error {
+	since, until, err := parseTimeRange(c.since, c.until, time.Now())
+	if err != nil {
+		return err
+	}
+
+	opts := usecase.ExportOptions{
+		Format: strings.ToLower(c.format),
+		Since:  since,
+		Until:  until,
+	}
+	if !slices.Contains(usecase.ExportFormats, opts.Format) {
+		return fmt.Errorf("invalid format %q (must be one of %s)", c.format, strings.Join(usecase.ExportFormats, ", "))
+	}
+
+	var w io.Writer = os.Stdout
+	var f *os.File
+	if c.file != "" {
+		if f, err = os.Create(c.file); err != nil {
+			return fmt.Errorf("create export file: %w", err)
+		}
+		w = f
+	}
+
+	count, err := c.reviewUc.ExportHistories(cmd.Context(), w, opts)
+	// Closing flushes the file, so a failed close is a failed export
+	if f != nil {
+		if cerr := f.Close(); err == nil && cerr != nil {
+			err = fmt.Errorf("close export file: %w", cerr)
+		}
+	}
+	if err != nil {
+		if c.file != "" {
+			_ = os.Remove(c.file)
+		}
+		return err
+	}
+
+	// Only report in file mode so stdout stays a clean export
+	if c.file != "" {
+		fmt.Printf("✅ Exported %d review request(s) to %s\n", count, c.file)
+	}
+	return nil
+}
+
+func (c *ReviewExportCmd) initFlags() {
+	flags := c.cmd.Flags()
+	flags.StringVar(&c.format, "format", usecase.ExportFormatCSV, "Export format: "+strings.Join(usecase.ExportFormats, ", "))
+	flags.StringVar(&c.since, "since", "", "Only requests submitted at or after this time (e.g. 2025-11-01, 30d)")
+	flags.StringVar(&c.until, "until", "", "Only requests submitted before this time (a date includes the whole day)")
+	flags.StringVar(&c.file, "file", "", "Write the export to this file instead of stdout")
+}
@@ -2,6 +2,7 @@ package cmd
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/spf13/cobra"
 	"github.com/yatbfi/cool/internal/domain/usecase"
@@ -14,6 +15,15 @@ type ReviewHistoryCmd struct {
 	reviewUc  usecase.Review
 	pending   bool
 	completed bool
+
+	priorities []string
+	since      string
+	until      string
+	jira       string
+	repo       string
+	search     string
+	sort       string
+	limit      int
 }
 
 // NewReviewHistoriesCmd creates a new review histories command
@@ -22,19 +32,32 @@ func NewReviewHistoriesCmd(reviewUc usecase.Review) *ReviewHistoryCmd {
 		reviewUc: reviewUc,
 	}
 	cmd.baseCmd = newBaseCommand(&cobra.Command{
-		Use:   "history",
-		Short: "Display review request history",
+		Use:     "history",
+		Aliases: []string{"histories"},
+		Short:   "Display review request history",
 		Long: `Display all review request history with their submission status.
 
 Use flags to filter the results:
 - --pending: Show only reviews not yet submitted to collaboration
 - --completed: Show only reviews already submitted to collaboration
+- --priority: Show only the given priorities, e.g. P0,P1
+- --since, --until: Submitted in a time range (2025-11-01, 7d, 36h, ...)
+- --jira: Linked to a Jira ticket (ABC-123) or project (ABC)
+- --repo: With a pull request in a repository (org/name)
+- --search: Text in the title or description
+
+Sort with --sort submitted (newest first), priority (most urgent first) or
+age (longest waiting first), and show at most --limit results.
 
 Examples:
   cool review histories              # Show all histories
   cool review histories --pending    # Show pending only
-  cool review histories --completed  # Show completed only`,
-		RunE: cmd.run,
+  cool review histories --completed  # Show completed only
+  cool review history --priority P0,P1 --since 7d
+  cool review history --repo org/payments --search "refund" --sort age --limit 10
+  cool review history --pending -o json  # JSON document for scripts`,
+		Annotations: outputFormats(outputJSON, outputYAML, outputWide),
+		RunE:        cmd.run,
 	})
 	cmd.initFlags()
 	return cmd
@@ -43,6 +66,11 @@ Examples:
 func (c *ReviewHistoryCmd) run(cmd *cobra.Command, _ []string) error {
 	ctx := cmd.Context()
 
+	format, err := outputFormat(cmd)
+	if err != nil {
+		return err
+	}
+
 	// Determine filter
 	filter := usecase.HistoryFilterAll
 	if c.pending && c.completed {
@@ -54,29 +82,64 @@ func (c *ReviewHistoryCmd) run(cmd *cobra.Command, _ []string) error {
 		filter = usecase.HistoryFilterCompleted
 	}
 
+	criteria, err := c.criteria(filter, time.Now())
+	if err != nil {
+		return err
+	}
+
 	// Get histories
-	histories, err := c.reviewUc.GetHistories(ctx, filter)
+	histories, err := c.reviewUc.FindHistories(ctx, criteria)
 	if err != nil {
 		return fmt.Errorf("get histories: %w", err)
 	}
 
 	// Display
-	if len(histories) == 0 {
+	switch {
+	case isStructuredOutput(format):
+		return printReviewList(format, histories)
+	case len(histories) == 0:
 		c.displayEmptyMessage(filter)
-	} else {
-		c.displayHistoriesTable(histories)
+	default:
+		c.displayHistoriesTable(histories, format)
 	}
 
 	return nil
 }
 
+// criteria adds the search flags to the status filter
+func (c *ReviewHistoryCmd) criteria(filter usecase.HistoryFilter, now time.Time) (usecase.HistoryCriteria, error) {
+	criteria := filter.Criteria()
+
+	since, until, err := parseTimeRange(c.since, c.until, now)
+	if err != nil {
+		return criteria, err
+	}
+
+	criteria.Priorities = c.priorities
+	criteria.SubmittedSince = since
+	criteria.SubmittedUntil = until
+	criteria.JiraKey = c.jira
+	criteria.Repository = c.repo
+	criteria.Search = c.search
+	criteria.Sort = usecase.HistorySort(c.sort)
+	criteria.Limit = c.limit
+	return criteria, nil
+}
+
+// hasSearchFlags reports whether results are narrowed beyond --pending/--completed
+func (c *ReviewHistoryCmd) hasSearchFlags() bool {
+	return len(c.priorities) > 0 || c.since != "" || c.until != "" || c.jira != "" || c.repo != "" || c.search != ""
+}
+
 func (c *ReviewHistoryCmd) displayEmptyMessage(filter usecase.HistoryFilter) {
 	fmt.Println()
 	fmt.Println("📝 No review history found")
-	switch filter {
-	case usecase.HistoryFilterPending:
+	switch {
+	case c.hasSearchFlags():
+		fmt.Println("   No review requests match the given filters.")
+	case filter == usecase.HistoryFilterPending:
 		fmt.Println("   No pending reviews to submit to collaboration.")
-	case usecase.HistoryFilterCompleted:
+	case filter == usecase.HistoryFilterCompleted:
 		fmt.Println("   No completed reviews submitted to collaboration.")
 	default:
 		fmt.Println("   Submit your first review request using:")
@@ -85,30 +148,24 @@ func (c *ReviewHistoryCmd) displayEmptyMessage(filter usecase.HistoryFilter) {
 	fmt.Println()
 }
 
-func (c *ReviewHistoryCmd) displayHistoriesTable(histories []*usecase.ReviewHistoryEntry) {
-	tbl := table.NewTable("ID", "Title", "Priority", "PRs", "Jira", "Submitted", "Collab Status", "Collab Submitted")
+func (c *ReviewHistoryCmd) displayHistoriesTable(histories []*usecase.ReviewHistoryEntry, format string) {
+	tbl := table.NewTable("ID", "Title", "Priority", "PRs", "Jira", "Submitted", "Status", "Collab Submitted")
 
 	for _, entry := range histories {
 		id := entry.ID
-		title := entry.Title
-		if len(title) > 40 {
-			title = title[:37] + "..."
-		}
+		title := truncateTitle(entry.Title, format)
 
 		prCount := fmt.Sprintf("%d", len(entry.ReviewLinks))
 		jiraCount := fmt.Sprintf("%d", len(entry.JiraLinks))
 		submittedAt := entry.SubmittedAt.Format("2006-01-02 15:04")
 
-		collabStatus := "⏳ Pending"
+		status := formatReviewStatus(entry.CurrentStatus())
 		collabSubmitted := "-"
-		if entry.SubmittedToCollab {
-			collabStatus = "✅ Submitted"
-			if entry.SubmittedToCollabAt != nil {
-				collabSubmitted = entry.SubmittedToCollabAt.Format("2006-01-02 15:04")
-			}
+		if entry.SubmittedToCollab && entry.SubmittedToCollabAt != nil {
+			collabSubmitted = entry.SubmittedToCollabAt.Format("2006-01-02 15:04")
 		}
 
-		tbl.AddRow(id, title, entry.Priority, prCount, jiraCount, submittedAt, collabStatus, collabSubmitted)
+		tbl.AddRow(id, title, entry.Priority, prCount, jiraCount, submittedAt, status, collabSubmitted)
 	}
 
 	tbl.Print()
@@ -122,4 +179,12 @@ func (c *ReviewHistoryCmd) initFlags() {
 	flags := c.cmd.Flags()
 	flags.BoolVar(&c.pending, "pending", false, "Show only pending reviews (not submitted to collaboration)")
 	flags.BoolVar(&c.completed, "completed", false, "Show only completed reviews (submitted to collaboration)")
+	flags.StringSliceVar(&c.priorities, "priority", nil, "Show only these priorities (e.g. P0,P1)")
+	flags.StringVar(&c.since, "since", "", "Show requests submitted at or after this time (e.g. 2025-11-01, 7d)")
+	flags.StringVar(&c.until, "until", "", "Show requests submitted before this time (a date includes the whole day)")
+	flags.StringVar(&c.jira, "jira", "", "Show requests linked to a Jira ticket (ABC-123) or project (ABC)")
+	flags.StringVar(&c.repo, "repo", "", "Show requests with a pull request in a repository (org/name)")
+	flags.StringVar(&c.search, "search", "", "Show requests with this text in the title or description")
+	flags.StringVar(&c.sort, "sort", "", "Sort by submitted (newest first), priority or age (oldest first)")
+	flags.IntVar(&c.limit, "limit", 0, "Show at most this many requests")
 }
@@ -0,0 +1,106 @@
+package cmd
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/yatbfi/cool/internal/domain/usecase"
+)
+
+// ReviewImportCmd merges review history from a file
+type ReviewImportCmd struct {
+	*baseCmd
+	reviewUc usecase.Review
+	format   string
+	dryRun   bool
+}
+
+// NewReviewImportCmd creates a new review import command
+func NewReviewImportCmd(reviewUc usecase.Review) *ReviewImportCmd {
+	cmd := &ReviewImportCmd{
+		reviewUc: reviewUc,
+	}
+	cmd.baseCmd = newBaseCommand(&cobra.Command{
+		Use:   "import <file>",
+		Short: "Import and merge review history from a file",
+		Long: `Import review history from another machine or an export and merge it with the
+local history.
+
+Accepted files:
+- a native ~/.cool-cli/review_histories.json, or 'cool review export --format json'
+- a CSV written by 'cool review export --format csv'
+
+The format is detected from the file extension, or the content when the extension is
+unknown; use --format to override. Entries are matched on ID. When an entry exists on
+both sides the most recently updated one is kept. CSV rows do not carry timelines, so
+an updated entry keeps its local events.
+
+Examples:
+  cool review import old-laptop/review_histories.json
+  cool review import reviews.csv --dry-run`,
+		Args: cobra.ExactArgs(1),
+		RunE: cmd.run,
+	})
+	cmd.initFlags()
+	return cmd
+}
+
+func (c *ReviewImportCmd) run(cmd *cobra.Command, args []string) error {
+	path := args[0]
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return fmt.Errorf("read import file: %w", err)
+	}
+
+	format := strings.ToLower(c.format)
+	if format == "" {
+		format = usecase.DetectImportFormat(path, data)
+	}
+
+	result, err := c.reviewUc.ImportHistories(cmd.Context(), bytes.NewReader(data), usecase.ImportOptions{
+		Format: format,
+		DryRun: c.dryRun,
+	})
+	if err != nil {
+		return fmt.Errorf("import %s: %w", path, err)
+	}
+
+	c.displayResult(result)
+	return nil
+}
+
+func (c *ReviewImportCmd) displayResult(result *usecase.ImportResult) {
+	fmt.Println()
+	if c.dryRun {
+		fmt.Println("🔍 Dry run, nothing was saved")
+		fmt.Println()
+	}
+
+	for _, entry := range result.Added {
+		fmt.Printf("   ➕ %s  %s\n", usecase.ShortID(entry.ID), entry.Title)
+	}
+	for _, entry := range result.Updated {
+		fmt.Printf("   🔄 %s  %s\n", usecase.ShortID(entry.ID), entry.Title)
+	}
+	for _, skipped := range result.Skipped {
+		fmt.Printf("   ⏭️  %s  %s (%s)\n", usecase.ShortID(skipped.Entry.ID), skipped.Entry.Title, skipped.Reason)
+	}
+	if len(result.Added)+len(result.Updated)+len(result.Skipped) > 0 {
+		fmt.Println()
+	}
+
+	verb := "Imported"
+	if c.dryRun {
+		verb = "Would import"
+	}
+	fmt.Printf("✅ %s: %d added, %d updated, %d skipped\n", verb, len(result.Added), len(result.Updated), len(result.Skipped))
+}
+
+func (c *ReviewImportCmd) initFlags() {
+	flags := c.cmd.Flags()
+	flags.StringVar(&c.format, "format", "", "File format: json or csv (detected by default)")
+	flags.BoolVar(&c.dryRun, "dry-run", false, "Show what would change without saving")
+}
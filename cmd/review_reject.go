@@ -0,0 +1,51 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"github.com/yatbfi/cool/internal/domain/usecase"
+)
+
+// ReviewRejectCmd marks a review request as needing changes
+type ReviewRejectCmd struct {
+	*baseCmd
+	reviewUc usecase.Review
+	note     string
+}
+
+// NewReviewRejectCmd creates a new review reject command
+func NewReviewRejectCmd(reviewUc usecase.Review) *ReviewRejectCmd {
+	cmd := &ReviewRejectCmd{
+		reviewUc: reviewUc,
+	}
+	cmd.baseCmd = newBaseCommand(&cobra.Command{
+		Use:   "reject <review-id>",
+		Short: "Request changes on a review request",
+		Long: `Mark a review request as changes-requested.
+
+Any previous tech lead or architect approval is cleared, so the request has to
+be approved again before it can be forwarded.
+
+Examples:
+  cool review reject abc123 --note "Missing migration rollback"`,
+		Args: cobra.ExactArgs(1),
+		RunE: cmd.run,
+	})
+	cmd.initFlags()
+	return cmd
+}
+
+func (c *ReviewRejectCmd) run(cmd *cobra.Command, args []string) error {
+	entry, err := c.reviewUc.RejectReview(cmd.Context(), args[0], c.note)
+	if err != nil {
+		return fmt.Errorf("reject review: %w", err)
+	}
+
+	printReviewStatusChange(entry)
+	return nil
+}
+
+func (c *ReviewRejectCmd) initFlags() {
+	c.cmd.Flags().StringVar(&c.note, "note", "", "Reason for requesting changes")
+}
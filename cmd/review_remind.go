@@ -0,0 +1,191 @@
+package cmd
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/yatbfi/cool/internal/domain/usecase"
+	"github.com/yatbfi/cool/internal/pkg/common"
+	"github.com/yatbfi/cool/internal/pkg/table"
+)
+
+// ReviewRemindCmd nudges reviewers about requests waiting longer than their SLA
+type ReviewRemindCmd struct {
+	*baseCmd
+	reviewUc usecase.Review
+	dryRun   bool
+	cooldown time.Duration
+}
+
+// NewReviewRemindCmd creates a new review remind command
+func NewReviewRemindCmd(reviewUc usecase.Review) *ReviewRemindCmd {
+	cmd := &ReviewRemindCmd{
+		reviewUc: reviewUc,
+	}
+	cmd.baseCmd = newBaseCommand(&cobra.Command{
+		Use:   "remind",
+		Short: "Remind reviewers about requests past their SLA",
+		Long: `Find review requests waiting longer than the SLA of their priority and post
+a reminder in their chat thread.
+
+Requests waiting for the tech lead (submitted) are reminded in the review
+channels, forwarded requests in the collaboration channels. A request is not
+reminded again within the cooldown.
+
+SLAs are configured per priority in ~/.cool-cli/config.json as "review_sla",
+using durations ("2h"), days ("3d") or business days ("2bd", weekdays only).
+Defaults: P0 = 2h, P1 = 1bd, P2 = 2bd, P3 = 5bd.
+
+Examples:
+  cool review remind --dry-run        Show overdue requests without posting
+  cool review remind                  Post reminders
+  cool review remind --cooldown 4h    Allow reminding again after 4 hours
+  cool review remind --cooldown 0     Remind every overdue request, however recent
+  cool review remind --dry-run -o json`,
+		Annotations: outputFormats(outputJSON, outputYAML),
+		RunE:        cmd.run,
+	})
+	cmd.initFlags()
+	return cmd
+}
+
+// remindOutput is the document printed by review remind with --output json|yaml
+type remindOutput struct {
+	Total   int                `json:"total"`
+	DryRun  bool               `json:"dry_run"`
+	Reviews []remindOutputItem `json:"reviews"`
+}
+
+// remindOutputItem is an overdue review request in machine-readable output
+type remindOutputItem struct {
+	ID             string     `json:"id"`
+	ShortID        string     `json:"short_id"`
+	Title          string     `json:"title"`
+	Priority       string     `json:"priority"`
+	Status         string     `json:"status"`
+	Stage          string     `json:"stage"`
+	WaitingSince   time.Time  `json:"waiting_since"`
+	WaitingSeconds int64      `json:"waiting_seconds"`
+	SLA            string     `json:"sla"`
+	LastReminder   *time.Time `json:"last_reminder"`
+	Outcome        string     `json:"outcome"`
+}
+
+func (c *ReviewRemindCmd) run(cmd *cobra.Command, _ []string) error {
+	ctx := cmd.Context()
+
+	format, err := outputFormat(cmd)
+	if err != nil {
+		return err
+	}
+
+	opts := usecase.RemindOptions{DryRun: c.dryRun}
+	if cmd.Flags().Changed("cooldown") {
+		if c.cooldown < 0 {
+			return fmt.Errorf("--cooldown must not be negative, got %s", c.cooldown)
+		}
+		opts.Cooldown = &c.cooldown
+	}
+	overdue, err := c.reviewUc.RemindOverdueReviews(ctx, opts)
+	if isStructuredOutput(format) {
+		if printErr := printRemindOutput(format, overdue, c.dryRun); printErr != nil {
+			return printErr
+		}
+		return err
+	}
+	if len(overdue) == 0 && err == nil {
+		fmt.Println()
+		fmt.Println("✅ No review requests are past their SLA")
+		fmt.Println()
+		return nil
+	}
+
+	now := time.Now()
+	tbl := table.NewTable("ID", "Title", "Priority", "Waiting", "SLA", "Last Reminder", "Result")
+	for _, o := range overdue {
+		title := o.Entry.Title
+		if len(title) > 40 {
+			title = title[:37] + "..."
+		}
+
+		lastReminder := "-"
+		if o.LastReminder != nil {
+			lastReminder = common.FormatDuration(now.Sub(*o.LastReminder)) + " ago"
+		}
+
+		tbl.AddRow(
+			usecase.ShortID(o.Entry.ID),
+			title,
+			o.Entry.Priority,
+			common.FormatDuration(o.Waiting),
+			o.SLA.String(),
+			lastReminder,
+			formatReminderOutcome(o.Outcome),
+		)
+	}
+
+	tbl.Print()
+	fmt.Printf("Total: %d overdue review(s)\n", tbl.RowCount())
+	fmt.Println()
+	if err != nil {
+		return err
+	}
+
+	for _, o := range overdue {
+		if o.Outcome == usecase.ReminderSent {
+			printUndeliveredNotifications(ctx, c.reviewUc, o.Entry.ID)
+		}
+	}
+	if c.dryRun {
+		fmt.Println("💡 Dry run, no reminders were posted. Run without --dry-run to send them.")
+		fmt.Println()
+	}
+
+	return nil
+}
+
+// printRemindOutput writes the overdue review requests as a JSON or YAML document
+func printRemindOutput(format string, overdue []*usecase.OverdueReview, dryRun bool) error {
+	doc := remindOutput{
+		Total:   len(overdue),
+		DryRun:  dryRun,
+		Reviews: make([]remindOutputItem, 0, len(overdue)),
+	}
+	for _, o := range overdue {
+		doc.Reviews = append(doc.Reviews, remindOutputItem{
+			ID:             o.Entry.ID,
+			ShortID:        usecase.ShortID(o.Entry.ID),
+			Title:          o.Entry.Title,
+			Priority:       o.Entry.Priority,
+			Status:         string(o.Entry.CurrentStatus()),
+			Stage:          o.Stage,
+			WaitingSince:   o.WaitingSince,
+			WaitingSeconds: int64(o.Waiting.Seconds()),
+			SLA:            o.SLA.String(),
+			LastReminder:   o.LastReminder,
+			Outcome:        o.Outcome,
+		})
+	}
+	return printStructured(format, doc)
+}
+
+// formatReminderOutcome returns a display label for a reminder outcome
+func formatReminderOutcome(outcome string) string {
+	switch outcome {
+	case usecase.ReminderSent:
+		return "⏰ Reminded"
+	case usecase.ReminderDryRun:
+		return "🔎 Would remind"
+	case usecase.ReminderCooldown:
+		return "💤 Cooldown"
+	default:
+		return "-"
+	}
+}
+
+func (c *ReviewRemindCmd) initFlags() {
+	flags := c.cmd.Flags()
+	flags.BoolVar(&c.dryRun, "dry-run", false, "Show overdue requests without posting reminders")
+	flags.DurationVar(&c.cooldown, "cooldown", 0, "Minimum time between reminders for the same request, 0 for none (default from config, 24h)")
+}
@@ -2,26 +2,54 @@ package cmd
 
 import (
 	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
+	"io"
 	"os"
+	"path/filepath"
+	"slices"
+	"strconv"
 	"strings"
 
 	"github.com/spf13/cobra"
 	"github.com/yatbfi/cool/config"
+	"github.com/yatbfi/cool/internal/domain/entity"
 	"github.com/yatbfi/cool/internal/domain/usecase"
 	"github.com/yatbfi/cool/internal/pkg/common"
+	"gopkg.in/yaml.v3"
 )
 
 // ReviewRequestCmd handles review request submission
 type ReviewRequestCmd struct {
 	*baseCmd
-	reviewUc usecase.Review
+	reviewUc   usecase.Review
+	codeHostUc usecase.CodeHost
+	jiraUc     usecase.Jira
+
+	title           string
+	priority        string
+	requestType     string
+	prLinks         []string
+	jiraLinks       []string
+	descriptionFile string
+	fromFile        string
+	yes             bool
+	resume          string
+	noGit           bool
+
+	// draftID is the stored draft backing this session, if any
+	draftID string
 }
 
 // NewReviewRequestCmd creates a new review request command
-func NewReviewRequestCmd(reviewUc usecase.Review) *ReviewRequestCmd {
+func NewReviewRequestCmd(reviewUc usecase.Review, codeHostUc usecase.CodeHost, jiraUc usecase.Jira) *ReviewRequestCmd {
 	cmd := &ReviewRequestCmd{
-		reviewUc: reviewUc,
+		reviewUc:   reviewUc,
+		codeHostUc: codeHostUc,
+		jiraUc:     jiraUc,
 	}
 	cmd.baseCmd = newBaseCommand(&cobra.Command{
 		Use:   "request",
@@ -30,33 +58,149 @@ func NewReviewRequestCmd(reviewUc usecase.Review) *ReviewRequestCmd {
 
 This command will prompt you for:
 - Review title
+- Request type (feature, bugfix, hotfix, refactor, migration; optional)
 - Description
 - Priority (P0-P4: Critical to Very Low)
 - Pull request links
 - Jira ticket links
 
-The request will be saved in your review history and sent to the configured Google Chat webhook.`,
+The request will be saved in your review history and sent to the configured Google Chat webhook.
+
+The request can also be built without prompts from flags or a request file
+(YAML or JSON, use "-" to read from stdin). Flags override values from the file.
+Use --yes to skip the preview/confirm step, e.g. from scripts or CI jobs.
+
+Inside a git work tree the prompts suggest a title and description from the
+branch's commits, a Jira link from a key in the branch name (e.g.
+feature/ABC-123-retry, needs jira_base_url) and the pull request of a branch
+checked out with 'gh pr checkout'. Press Enter to accept a suggestion, or use
+--no-git to turn this off.
+
+With github_token or gitlab_token configured (or GITHUB_TOKEN / GITLAB_TOKEN set),
+the preview shows each pull request's title, author, state, size and CI status,
+and the message gets a one-line summary per pull request.
+
+With jira_token configured, every Jira issue must exist; the preview and the
+message show its summary, status and assignee. jira_actions can transition the
+issues and comment with the pull request links once the request is sent.
+
+A request type opens the description editor with the type's template. Sections
+marked as required (e.g. "Testing done" or "Rollback plan") must be filled in
+before the request can be sent. Templates can be overridden with <type>.md in
+.cool-cli/templates or ~/.cool-cli/templates.
+
+While you are editing, the request is saved as a draft, so nothing is lost if
+the editor crashes or the terminal closes. List drafts with 'cool review drafts'
+and continue one with --resume.
+
+Examples:
+  cool review request
+  cool review request --title "Add payment retry" --priority P1 \
+    --pr https://github.com/org/repo/pull/42 --jira https://org.atlassian.net/browse/PAY-12 \
+    --description-file notes.md --yes
+  cool review request --type hotfix --title "Fix checkout timeout" --description-file hotfix.md
+  cool review request --from-file request.yaml --yes
+  cool review request --resume abc123
+  git log -1 --format=%b | cool review request --title "Fix login" --description-file - --yes`,
 		RunE: cmd.run,
 	})
+	cmd.initFlags()
 	return cmd
 }
 
 func (c *ReviewRequestCmd) run(cmd *cobra.Command, _ []string) error {
+	err := c.runRequest(cmd)
+	if err != nil && c.draftID != "" {
+		fmt.Println()
+		fmt.Printf("💾 Your input is kept as draft %s. Resume with:\n", c.draftID)
+		fmt.Printf("   cool review request --resume %s\n", c.draftID)
+		fmt.Println()
+	}
+	return err
+}
+
+func (c *ReviewRequestCmd) runRequest(cmd *cobra.Command) error {
 	ctx := cmd.Context()
 
+	if c.resume != "" {
+		return c.resumeDraft(ctx)
+	}
+
+	if c.isNonInteractive(cmd) {
+		req, err := c.buildReviewRequestFromFlags(cmd)
+		if err != nil {
+			return err
+		}
+		if err := req.Validate(); err != nil {
+			return fmt.Errorf("invalid review request: %w", err)
+		}
+		setProjectDir(req)
+		if c.yes {
+			return c.submit(ctx, req)
+		}
+		return c.previewLoop(ctx, req)
+	}
+
 	fmt.Println()
 	fmt.Println("📝 Submit Review Request to Tech Lead")
 	fmt.Println("=====================================")
 	fmt.Println()
 
 	// Collect input first time
-	req, err := c.collectReviewRequest()
+	req, err := c.collectReviewRequest(ctx)
 	if err != nil {
 		return err
 	}
+	setProjectDir(req)
+
+	return c.previewLoop(ctx, req)
+}
+
+// resumeDraft loads a stored draft and continues with the preview/edit loop
+func (c *ReviewRequestCmd) resumeDraft(ctx context.Context) error {
+	entry, err := c.reviewUc.GetHistoryByID(ctx, c.resume)
+	if err != nil {
+		return fmt.Errorf("get draft: %w", err)
+	}
+	if status := entry.CurrentStatus(); status != entity.ReviewStatusDraft {
+		return fmt.Errorf("review %s is not a draft (status: %s)", entry.ID, status)
+	}
+
+	c.draftID = entry.ID
+	req := usecase.ReviewRequestFromEntry(entry)
+	if req.Priority == "" {
+		req.Priority = usecase.DefaultPriority
+	}
+
+	fmt.Println()
+	fmt.Printf("📝 Resuming draft %s\n", entry.ID)
+
+	if c.yes {
+		return c.submit(ctx, req)
+	}
+	return c.previewLoop(ctx, req)
+}
+
+// saveDraft stores the current input so it survives crashes and interruptions.
+// Failures are reported but never abort the request.
+func (c *ReviewRequestCmd) saveDraft(ctx context.Context, req *usecase.ReviewRequest) {
+	entry, err := c.reviewUc.SaveDraft(ctx, c.draftID, req)
+	if err != nil {
+		fmt.Printf("⚠️  Could not save draft: %s\n", err.Error())
+		return
+	}
+
+	if c.draftID == "" {
+		fmt.Printf("💾 Draft saved (ID: %s)\n", entry.ID)
+	}
+	c.draftID = entry.ID
+}
 
-	// Main loop for preview and confirmation
+// previewLoop shows the formatted message and lets the user submit, edit or cancel
+func (c *ReviewRequestCmd) previewLoop(ctx context.Context, req *usecase.ReviewRequest) error {
 	for {
+		c.saveDraft(ctx, req)
+
 		// Preview first (without sending)
 		fmt.Println()
 		fmt.Println("📋 Preview Review Request")
@@ -66,81 +210,418 @@ func (c *ReviewRequestCmd) run(cmd *cobra.Command, _ []string) error {
 		if err != nil {
 			return fmt.Errorf("generate preview: %w", err)
 		}
+		if c.draftID != "" {
+			previewEntry.ID = c.draftID
+		}
 
 		// Display preview message
-		message := c.reviewUc.FormatReviewRequestMessage(previewEntry)
+		message, err := c.reviewUc.FormatReviewRequestMessage(previewEntry)
+		if err != nil {
+			return fmt.Errorf("format message: %w", err)
+		}
 		fmt.Println()
 		fmt.Println(message)
 		fmt.Println()
+		c.printPullRequests(ctx, previewEntry.ReviewLinks)
+		c.printJiraIssues(ctx, previewEntry.JiraLinks)
+		printRoute(previewEntry)
+		printReviewers(previewEntry)
+		printMissingSections(req)
 
 		// Ask for confirmation with edit option
-		fmt.Print("Do you want to (s)ubmit, (e)dit, or (c)ancel? [s/e/c]: ")
-		var action string
-		_, _ = fmt.Scanln(&action)
+		fmt.Print("Do you want to (s)ubmit, (e)dit, save as (d)raft, or (c)ancel? [s/e/d/c]: ")
+		action, err := bufio.NewReader(os.Stdin).ReadString('\n')
+		if err != nil {
+			// Nothing to confirm with (stdin is closed or was already read by a "-" flag),
+			// so never submit without an answer
+			fmt.Println()
+			return fmt.Errorf("no answer received, review request not submitted (use --yes to submit without confirmation)")
+		}
 		action = strings.ToLower(strings.TrimSpace(action))
 
 		switch action {
 		case "s", "submit", "":
-			// Submit request
-			fmt.Println()
-			fmt.Println("⏳ Submitting review request...")
-
-			entry, err := c.reviewUc.SubmitReviewRequest(ctx, req, true)
-			if err != nil {
-				return fmt.Errorf("submit review request: %w", err)
-			}
-
-			// Display success
-			fmt.Println()
-			fmt.Println("✅ Review request submitted successfully!")
-			fmt.Println()
-			fmt.Printf("   Request ID: %s\n", entry.ID)
-			fmt.Printf("   Title: %s\n", entry.Title)
-			fmt.Printf("   Priority: %s\n", entry.Priority)
-			fmt.Printf("   Submitted at: %s\n", entry.SubmittedAt.Format("2006-01-02 15:04:05"))
-			fmt.Println()
-			fmt.Println("💡 Your request has been sent to tech lead for review.")
-			fmt.Println("   Once approved, you can forward it to head architect using:")
-			fmt.Printf("   cool review submit-collab %s\n", entry.ID)
-			fmt.Println()
-
-			return nil
+			return c.submit(ctx, req)
 
 		case "e", "edit":
 			// Ask what to edit
-			editReq, err := c.editReviewRequest(req)
+			editReq, err := editReviewRequest(req)
 			if err != nil {
 				return err
 			}
 			req = editReq
 			// Continue loop to show preview again
 
+		case "d", "draft":
+			fmt.Println()
+			fmt.Printf("💾 Draft saved. Resume later with: cool review request --resume %s\n", c.draftID)
+			fmt.Println()
+			return nil
+
 		case "c", "cancel":
+			// A resumed draft was saved on purpose, so only drafts of this session are discarded
+			if c.resume != "" {
+				fmt.Println("\n❌ Review request cancelled")
+				fmt.Printf("💾 Draft kept. Resume it with 'cool review request --resume %s' or discard it with 'cool review drafts --delete %s'\n", c.draftID, c.draftID)
+				return nil
+			}
+			if c.draftID != "" {
+				if err := c.reviewUc.DeleteDraft(ctx, c.draftID); err != nil {
+					return fmt.Errorf("discard draft: %w", err)
+				}
+				c.draftID = ""
+			}
 			fmt.Println("\n❌ Review request cancelled")
 			return nil
 
 		default:
-			fmt.Println("❌ Invalid option. Please choose (s)ubmit, (e)dit, or (c)ancel.")
+			fmt.Println("❌ Invalid option. Please choose (s)ubmit, (e)dit, save as (d)raft, or (c)ancel.")
 			fmt.Println()
 			// Continue loop
 		}
 	}
 }
 
-func (c *ReviewRequestCmd) collectReviewRequest() (*usecase.ReviewRequest, error) {
+// printPullRequests shows the code host details of the pull requests in the preview.
+// Lookup problems are shown as hints; they never block the request.
+func (c *ReviewRequestCmd) printPullRequests(ctx context.Context, links []string) {
+	if c.codeHostUc == nil || len(links) == 0 {
+		return
+	}
+
+	var lines, hints []string
+	for _, link := range links {
+		info, err := c.codeHostUc.GetPullRequest(ctx, link)
+		switch {
+		case errors.Is(err, usecase.ErrUnsupportedCodeHost):
+			continue
+		case errors.Is(err, usecase.ErrNoCodeHostToken):
+			if !slices.Contains(hints, err.Error()) {
+				hints = append(hints, err.Error())
+			}
+			continue
+		case err != nil:
+			lines = append(lines, fmt.Sprintf("   ⚠️  %s: %s", link, err.Error()))
+			continue
+		}
+
+		lines = append(lines,
+			fmt.Sprintf("   %s#%d %s", info.Repository, info.Number, info.Title),
+			fmt.Sprintf("      %s", info.Summary()),
+		)
+	}
+
+	if len(lines) > 0 {
+		fmt.Println("🔀 Pull Requests:")
+		for _, line := range lines {
+			fmt.Println(line)
+		}
+		fmt.Println()
+	}
+	for _, hint := range hints {
+		fmt.Printf("💡 No pull request details shown (%s)\n", hint)
+	}
+	if len(hints) > 0 {
+		fmt.Println()
+	}
+}
+
+// printMissingSections flags the required description sections of a typed request that are
+// not filled in yet, since they block submission
+func printMissingSections(req *usecase.ReviewRequest) {
+	missing, err := req.MissingSections()
+	if err != nil {
+		fmt.Printf("⚠️  %s\n\n", err.Error())
+		return
+	}
+	if len(missing) == 0 {
+		return
+	}
+
+	fmt.Printf("📝 %s sections to fill in before submitting:\n", usecase.RequestTypeLabel(req.Type))
+	for _, heading := range missing {
+		fmt.Printf("   ❌ %s\n", heading)
+	}
+	fmt.Println("   Choose (e)dit → Description to complete them.")
+	fmt.Println()
+}
+
+// printRoute shows which channels the request goes to and which routing rule picked them.
+// Nothing is shown when no routes are configured.
+func printRoute(entry *usecase.ReviewHistoryEntry) {
+	if len(config.GetConfig().Routes) == 0 {
+		return
+	}
+
+	if match := usecase.MatchRoute(entry); match != nil {
+		fmt.Printf("🧭 Route: %s (%s)\n", match.Route.Name, match.Reason)
+	} else {
+		fmt.Println("🧭 Route: none matched, using the stage channels")
+	}
+	for _, stage := range []string{config.StageReview, config.StageCollab} {
+		channels, err := usecase.RouteChannels(entry, stage)
+		if err != nil {
+			fmt.Printf("   %-6s → ⚠️  %s\n", stage, err.Error())
+			continue
+		}
+		var names []string
+		for _, ch := range channels {
+			names = append(names, fmt.Sprintf("%s (%s)", ch.Name, ch.Type))
+		}
+		fmt.Printf("   %-6s → %s\n", stage, strings.Join(names, ", "))
+	}
+	fmt.Println()
+}
+
+// printReviewers shows the team members mentioned in the messages of each stage.
+// Nothing is shown when no team roster is configured.
+func printReviewers(entry *usecase.ReviewHistoryEntry) {
+	if len(config.GetConfig().Team) == 0 {
+		return
+	}
+
+	squad := usecase.RequestSquad(entry)
+	if squad == "" {
+		squad = "no squad"
+	}
+	fmt.Printf("👀 Mentions (%s):\n", squad)
+	for _, stage := range []string{config.StageReview, config.StageCollab} {
+		var names []string
+		for _, m := range usecase.Reviewers(entry, stage) {
+			if m.GChatUserID != "" {
+				names = append(names, fmt.Sprintf("%s <users/%s>", m.Name, m.GChatUserID))
+			} else {
+				names = append(names, m.Name+" (no Google Chat user ID)")
+			}
+		}
+		if len(names) == 0 {
+			names = append(names, "nobody, add one with 'cool team add'")
+		}
+		fmt.Printf("   %-6s → %s\n", stage, strings.Join(names, ", "))
+	}
+	fmt.Println()
+}
+
+// printJiraIssues shows the Jira details of the issues in the preview. Issues that do not
+// exist are flagged, since they block submission.
+func (c *ReviewRequestCmd) printJiraIssues(ctx context.Context, links []string) {
+	if c.jiraUc == nil || len(links) == 0 {
+		return
+	}
+
+	var lines, hints []string
+	for _, link := range links {
+		info, err := c.jiraUc.GetIssue(ctx, link)
+		switch {
+		case errors.Is(err, usecase.ErrJiraNotConfigured):
+			if !slices.Contains(hints, err.Error()) {
+				hints = append(hints, err.Error())
+			}
+			continue
+		case errors.Is(err, usecase.ErrJiraIssueNotFound):
+			lines = append(lines, fmt.Sprintf("   ❌ %s does not exist, fix it before submitting", link))
+			continue
+		case err != nil:
+			lines = append(lines, fmt.Sprintf("   ⚠️  %s: %s", link, err.Error()))
+			continue
+		}
+
+		assignee := info.Assignee
+		if assignee == "" {
+			assignee = "unassigned"
+		}
+		lines = append(lines,
+			fmt.Sprintf("   %s %s", info.Key, info.Summary),
+			fmt.Sprintf("      %s · %s", info.Status, assignee),
+		)
+	}
+
+	if len(lines) > 0 {
+		fmt.Println("🎫 Jira Issues:")
+		for _, line := range lines {
+			fmt.Println(line)
+		}
+		fmt.Println()
+	}
+	for _, hint := range hints {
+		fmt.Printf("💡 No Jira details shown (%s)\n", hint)
+	}
+	if len(hints) > 0 {
+		fmt.Println()
+	}
+}
+
+func (c *ReviewRequestCmd) submit(ctx context.Context, req *usecase.ReviewRequest) error {
+	fmt.Println()
+	fmt.Println("⏳ Submitting review request...")
+
+	var entry *usecase.ReviewHistoryEntry
+	var err error
+	if c.draftID != "" {
+		entry, err = c.reviewUc.SubmitDraft(ctx, c.draftID, req)
+	} else {
+		entry, err = c.reviewUc.SubmitReviewRequest(ctx, req, true)
+	}
+	if err != nil {
+		return fmt.Errorf("submit review request: %w", err)
+	}
+	c.draftID = ""
+
+	// Display success
+	fmt.Println()
+	fmt.Println("✅ Review request submitted successfully!")
+	fmt.Println()
+	fmt.Printf("   Request ID: %s\n", entry.ID)
+	fmt.Printf("   Title: %s\n", entry.Title)
+	fmt.Printf("   Priority: %s\n", entry.Priority)
+	if entry.Type != "" {
+		fmt.Printf("   Type: %s\n", usecase.RequestTypeLabel(entry.Type))
+	}
+	fmt.Printf("   Submitted at: %s\n", entry.SubmittedAt.Format("2006-01-02 15:04:05"))
+	fmt.Println()
+	printUndeliveredNotifications(ctx, c.reviewUc, entry.ID)
+	printJiraUpdates(entry)
+	fmt.Println("💡 Your request has been sent to tech lead for review.")
+	fmt.Println("   Once approved, you can forward it to head architect using:")
+	fmt.Printf("   cool review submit-collab %s\n", entry.ID)
+	fmt.Println()
+
+	return nil
+}
+
+// isNonInteractive reports whether the request should be built from flags/file instead of prompts
+func (c *ReviewRequestCmd) isNonInteractive(cmd *cobra.Command) bool {
+	for _, name := range []string{"title", "priority", "type", "pr", "jira", "description-file", "from-file", "yes"} {
+		if cmd.Flags().Changed(name) {
+			return true
+		}
+	}
+	return false
+}
+
+// buildReviewRequestFromFlags loads the optional request file and overlays explicitly set flags
+func (c *ReviewRequestCmd) buildReviewRequestFromFlags(cmd *cobra.Command) (*usecase.ReviewRequest, error) {
+	req := &usecase.ReviewRequest{}
+	if c.fromFile != "" {
+		loaded, err := loadReviewRequestFile(c.fromFile)
+		if err != nil {
+			return nil, err
+		}
+		req = loaded
+	}
+
+	flags := cmd.Flags()
+	if flags.Changed("title") {
+		req.Title = c.title
+	}
+	if flags.Changed("priority") {
+		req.Priority = c.priority
+	}
+	if flags.Changed("type") {
+		req.Type = c.requestType
+	}
+	if flags.Changed("pr") {
+		req.ReviewLinks = c.prLinks
+	}
+	if flags.Changed("jira") {
+		req.JiraLinks = c.jiraLinks
+	}
+	if c.descriptionFile != "" {
+		if c.descriptionFile == "-" && c.fromFile == "-" {
+			return nil, fmt.Errorf("--description-file and --from-file cannot both read from stdin")
+		}
+		data, err := readFileOrStdin(c.descriptionFile)
+		if err != nil {
+			return nil, fmt.Errorf("read description file: %w", err)
+		}
+		req.Description = string(data)
+	}
+
+	req.Title = strings.TrimSpace(req.Title)
+	req.Description = strings.TrimSpace(req.Description)
+	req.Priority = usecase.NormalizePriority(req.Priority)
+	if req.Priority == "" {
+		req.Priority = usecase.DefaultPriority
+	}
+	req.Type = usecase.NormalizeRequestType(req.Type)
+	if err := req.NormalizeLinks(); err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// loadReviewRequestFile parses a YAML or JSON request document ("-" reads stdin)
+func loadReviewRequestFile(path string) (*usecase.ReviewRequest, error) {
+	data, err := readFileOrStdin(path)
+	if err != nil {
+		return nil, fmt.Errorf("read request file: %w", err)
+	}
+
+	var req usecase.ReviewRequest
+	if strings.EqualFold(filepath.Ext(path), ".json") {
+		decoder := json.NewDecoder(bytes.NewReader(data))
+		decoder.DisallowUnknownFields()
+		if err := decoder.Decode(&req); err != nil {
+			return nil, fmt.Errorf("parse request file %s: %w", path, err)
+		}
+		return &req, nil
+	}
+
+	// YAML is a superset of JSON, so this also handles JSON read from stdin
+	decoder := yaml.NewDecoder(bytes.NewReader(data))
+	decoder.KnownFields(true)
+	if err := decoder.Decode(&req); err != nil && !errors.Is(err, io.EOF) {
+		return nil, fmt.Errorf("parse request file %s: %w", path, err)
+	}
+	return &req, nil
+}
+
+func readFileOrStdin(path string) ([]byte, error) {
+	if path == "-" {
+		return io.ReadAll(os.Stdin)
+	}
+	return os.ReadFile(path)
+}
+
+func (c *ReviewRequestCmd) collectReviewRequest(ctx context.Context) (*usecase.ReviewRequest, error) {
 	reader := bufio.NewReader(os.Stdin)
 
+	prefill := c.gitPrefill(ctx)
+	var suggested usecase.ReviewRequest
+	if prefill != nil {
+		suggested = prefill.Request
+	}
+
 	// Title - keep as single line input for simplicity
-	fmt.Print("Review Title: ")
+	if suggested.Title != "" {
+		fmt.Printf("Review Title [%s]: ", suggested.Title)
+	} else {
+		fmt.Print("Review Title: ")
+	}
 	title, err := reader.ReadString('\n')
 	if err != nil {
 		return nil, fmt.Errorf("read title: %w", err)
 	}
 	title = strings.TrimSpace(title)
+	if title == "" {
+		title = suggested.Title
+	}
 	if title == "" {
 		return nil, fmt.Errorf("title is required")
 	}
 
+	req := &usecase.ReviewRequest{Title: title, Priority: usecase.DefaultPriority}
+
+	// Request type - picks the description template
+	fmt.Println()
+	requestType, err := readRequestType(reader, "")
+	if err != nil {
+		return nil, err
+	}
+	req.Type = requestType
+	c.saveDraft(ctx, req)
+
 	// Description - use editor for multiline input
 	fmt.Println()
 
@@ -152,12 +633,26 @@ func (c *ReviewRequestCmd) collectReviewRequest() (*usecase.ReviewRequest, error
 	}
 
 	fmt.Printf("Opening editor (%s) for description...\n", common.GetEditorDisplayName(editorCmd))
-	description, err := common.OpenEditor(editorCmd, "Enter your review description below")
+	var description string
+	if req.Type != "" {
+		var content string
+		content, err = descriptionEditorContent(req.Type, suggested.Description)
+		if err != nil {
+			return nil, err
+		}
+		description, err = common.OpenEditorWithContent(editorCmd, content)
+	} else if suggested.Description != "" {
+		description, err = common.OpenEditorWithContent(editorCmd, suggested.Description)
+	} else {
+		description, err = common.OpenEditor(editorCmd, "Enter your review description below")
+	}
 	if err != nil {
 		return nil, fmt.Errorf("open editor: %w", err)
 	}
 
 	fmt.Println("✓ Description captured")
+	req.Description = usecase.StripDescriptionComments(description)
+	c.saveDraft(ctx, req)
 
 	// Priority
 	var priority string
@@ -186,24 +681,70 @@ func (c *ReviewRequestCmd) collectReviewRequest() (*usecase.ReviewRequest, error
 		break
 	}
 
+	req.Priority = priority
+
 	// Review Links
-	fmt.Println("Pull Request Links (one per line, empty line to finish):")
-	reviewLinks := c.collectLinks(reader)
+	fmt.Println("Pull Request Links (URL or owner/repo#42, one per line, empty line to finish):")
+	if prefill != nil && prefill.NewPullRequestURL != "" {
+		fmt.Printf("  💡 No pull request found for %s yet. Open one at:\n     %s\n", prefill.Branch, prefill.NewPullRequestURL)
+	}
+	req.ReviewLinks = collectLinks(reader, usecase.NormalizePRLink, suggested.ReviewLinks)
+	c.saveDraft(ctx, req)
 
 	// Jira Links
-	fmt.Println("Jira Ticket Links (one per line, empty line to finish):")
-	jiraLinks := c.collectLinks(reader)
-
-	return &usecase.ReviewRequest{
-		Title:       title,
-		Description: description,
-		Priority:    priority,
-		ReviewLinks: reviewLinks,
-		JiraLinks:   jiraLinks,
-	}, nil
+	fmt.Println("Jira Ticket Links (URL or key like ABC-123, one per line, empty line to finish):")
+	if prefill != nil && prefill.JiraKey != "" && len(suggested.JiraLinks) == 0 {
+		fmt.Printf("  💡 Found %s in the branch name. Set jira_base_url in ~/.cool-cli/config.json to link it automatically.\n", prefill.JiraKey)
+	}
+	req.JiraLinks = collectLinks(reader, usecase.NormalizeJiraLink, suggested.JiraLinks)
+
+	return req, nil
+}
+
+// setProjectDir records the project directory of the working directory for routing,
+// unless the request file already names one
+func setProjectDir(req *usecase.ReviewRequest) {
+	if req.ProjectDir != "" {
+		return
+	}
+	if dir, err := os.Getwd(); err == nil {
+		req.ProjectDir = config.GetConfig().ProjectDir(dir)
+	}
+}
+
+// gitPrefill suggests request fields from the current git branch, nil outside a git work tree
+func (c *ReviewRequestCmd) gitPrefill(ctx context.Context) *usecase.GitPrefill {
+	if c.noGit {
+		return nil
+	}
+
+	dir, err := os.Getwd()
+	if err != nil {
+		return nil
+	}
+	info, err := common.ReadGitBranchInfo(dir)
+	if err != nil {
+		if !errors.Is(err, common.ErrNotGitRepository) {
+			fmt.Printf("⚠️  Not pre-filling from git: %s\n\n", err.Error())
+		}
+		return nil
+	}
+
+	fmt.Printf("🌿 Suggestions from git branch %s, press Enter to accept them\n\n", info.Branch)
+	return usecase.PrefillFromGit(ctx, info, c.codeHostUc)
 }
 
-func (c *ReviewRequestCmd) collectLinks(reader *bufio.Reader) []string {
+// collectLinks reads links line by line until an empty line. Invalid links are
+// rejected so they can be re-entered, duplicates are skipped. Suggested links are
+// kept when the first line is empty.
+func collectLinks(reader *bufio.Reader, normalize func(string) (string, error), suggested []string) []string {
+	for _, link := range suggested {
+		fmt.Printf("  Suggested: %s\n", link)
+	}
+	if len(suggested) > 0 {
+		fmt.Println("  (press Enter to keep, or enter links to replace)")
+	}
+
 	var links []string
 	for {
 		fmt.Print("  ")
@@ -213,14 +754,28 @@ func (c *ReviewRequestCmd) collectLinks(reader *bufio.Reader) []string {
 		}
 		link = strings.TrimSpace(link)
 		if link == "" {
+			if len(links) == 0 {
+				return suggested
+			}
 			break
 		}
+
+		link, err = normalize(link)
+		if err != nil {
+			fmt.Printf("  ❌ %s\n", err.Error())
+			continue
+		}
+		if slices.Contains(links, link) {
+			fmt.Println("  ⚠️  Already added, skipped")
+			continue
+		}
 		links = append(links, link)
 	}
 	return links
 }
 
-func (c *ReviewRequestCmd) editReviewRequest(req *usecase.ReviewRequest) (*usecase.ReviewRequest, error) {
+// editReviewRequest asks which field to change and updates the request in place
+func editReviewRequest(req *usecase.ReviewRequest) (*usecase.ReviewRequest, error) {
 	reader := bufio.NewReader(os.Stdin)
 	cfg := config.GetConfig()
 
@@ -231,7 +786,8 @@ func (c *ReviewRequestCmd) editReviewRequest(req *usecase.ReviewRequest) (*useca
 	fmt.Println("  3. Priority")
 	fmt.Println("  4. Pull Request Links")
 	fmt.Println("  5. Jira Ticket Links")
-	fmt.Print("Select field to edit [1-5]: ")
+	fmt.Println("  6. Request Type")
+	fmt.Print("Select field to edit [1-6]: ")
 
 	choice, err := reader.ReadString('\n')
 	if err != nil {
@@ -259,12 +815,18 @@ func (c *ReviewRequestCmd) editReviewRequest(req *usecase.ReviewRequest) (*useca
 		if err != nil {
 			return req, fmt.Errorf("get editor: %w", err)
 		}
+		content := req.Description
+		if req.Type != "" {
+			if content, err = descriptionEditorContent(req.Type, req.Description); err != nil {
+				return req, err
+			}
+		}
 		fmt.Printf("\nOpening editor (%s) for description...\n", common.GetEditorDisplayName(editorCmd))
-		description, err := common.OpenEditorWithContent(editorCmd, req.Description)
+		description, err := common.OpenEditorWithContent(editorCmd, content)
 		if err != nil {
 			return req, fmt.Errorf("open editor: %w", err)
 		}
-		req.Description = description
+		req.Description = usecase.StripDescriptionComments(description)
 		fmt.Println("✓ Description updated")
 
 	case "3":
@@ -299,8 +861,8 @@ func (c *ReviewRequestCmd) editReviewRequest(req *usecase.ReviewRequest) (*useca
 		for i, link := range req.ReviewLinks {
 			fmt.Printf("  %d. %s\n", i+1, link)
 		}
-		fmt.Println("\nEnter new Pull Request Links (one per line, empty line to finish):")
-		req.ReviewLinks = c.collectLinks(reader)
+		fmt.Println("\nEnter new Pull Request Links (URL or owner/repo#42, one per line, empty line to finish):")
+		req.ReviewLinks = collectLinks(reader, usecase.NormalizePRLink, nil)
 
 	case "5":
 		// Edit Jira Links
@@ -308,8 +870,20 @@ func (c *ReviewRequestCmd) editReviewRequest(req *usecase.ReviewRequest) (*useca
 		for i, link := range req.JiraLinks {
 			fmt.Printf("  %d. %s\n", i+1, link)
 		}
-		fmt.Println("\nEnter new Jira Ticket Links (one per line, empty line to finish):")
-		req.JiraLinks = c.collectLinks(reader)
+		fmt.Println("\nEnter new Jira Ticket Links (URL or key like ABC-123, one per line, empty line to finish):")
+		req.JiraLinks = collectLinks(reader, usecase.NormalizeJiraLink, nil)
+
+	case "6":
+		// Edit Request Type
+		fmt.Printf("Current type: %s\n", formatRequestType(req.Type))
+		requestType, err := readRequestType(reader, req.Type)
+		if err != nil {
+			return req, err
+		}
+		req.Type = requestType
+		if missing, err := req.MissingSections(); err == nil && len(missing) > 0 {
+			fmt.Println("💡 Edit the description to add the sections of the new type's template.")
+		}
 
 	default:
 		fmt.Println("❌ Invalid choice. No changes made.")
@@ -318,6 +892,56 @@ func (c *ReviewRequestCmd) editReviewRequest(req *usecase.ReviewRequest) (*useca
 	return req, nil
 }
 
+// readRequestType asks for a request type by number. Enter keeps current ("" for none)
+// and 0 clears the type.
+func readRequestType(reader *bufio.Reader, current string) (string, error) {
+	for {
+		fmt.Println("Request Type:")
+		fmt.Println("  0. None")
+		for i, t := range usecase.RequestTypes {
+			fmt.Printf("  %d. %s\n", i+1, usecase.RequestTypeLabel(t))
+		}
+		fmt.Printf("Select type [%s]: ", formatRequestType(current))
+		input, err := reader.ReadString('\n')
+		if err != nil {
+			return "", fmt.Errorf("read request type: %w", err)
+		}
+		input = strings.TrimSpace(input)
+
+		switch {
+		case input == "":
+			return current, nil
+		case input == "0":
+			return "", nil
+		}
+		if t := usecase.NormalizeRequestType(input); slices.Contains(usecase.RequestTypes, t) {
+			return t, nil
+		}
+		if n, err := strconv.Atoi(input); err == nil && n >= 1 && n <= len(usecase.RequestTypes) {
+			return usecase.RequestTypes[n-1], nil
+		}
+		fmt.Printf("❌ invalid request type selection: %s (must be 0-%d). Please try again.\n\n", input, len(usecase.RequestTypes))
+	}
+}
+
+// formatRequestType returns the label of a request type, or "none" for untyped requests
+func formatRequestType(requestType string) string {
+	if requestType == "" {
+		return "none"
+	}
+	return usecase.RequestTypeLabel(requestType)
+}
+
+// descriptionEditorContent returns the description with the sections of the type's
+// template it is still missing, ready to be completed in the editor
+func descriptionEditorContent(requestType, description string) (string, error) {
+	tmpl, err := usecase.LoadDescriptionTemplate(requestType)
+	if err != nil {
+		return "", err
+	}
+	return tmpl.Scaffold(description), nil
+}
+
 func getPriorityFromSelection(selection string) (string, error) {
 	priorities := map[string]string{
 		"1": "P0",
@@ -334,3 +958,17 @@ func getPriorityFromSelection(selection string) (string, error) {
 
 	return priority, nil
 }
+
+func (c *ReviewRequestCmd) initFlags() {
+	flags := c.cmd.Flags()
+	flags.StringVar(&c.title, "title", "", "Review title")
+	flags.StringVar(&c.priority, "priority", "", "Priority (P0-P4, default P2)")
+	flags.StringVar(&c.requestType, "type", "", "Request type: feature, bugfix, hotfix, refactor or migration")
+	flags.StringSliceVar(&c.prLinks, "pr", nil, "Pull request link or owner/repo#42 (repeatable or comma-separated)")
+	flags.StringSliceVar(&c.jiraLinks, "jira", nil, "Jira ticket link or key like ABC-123 (repeatable or comma-separated)")
+	flags.StringVar(&c.descriptionFile, "description-file", "", "Read description from file (\"-\" for stdin)")
+	flags.StringVarP(&c.fromFile, "from-file", "f", "", "Read the request from a YAML/JSON file (\"-\" for stdin)")
+	flags.BoolVarP(&c.yes, "yes", "y", false, "Submit without preview and confirmation")
+	flags.StringVar(&c.resume, "resume", "", "Continue editing a saved draft by ID")
+	flags.BoolVar(&c.noGit, "no-git", false, "Do not pre-fill the request from the current git branch")
+}
@@ -0,0 +1,153 @@
+package cmd
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/yatbfi/cool/internal/domain/entity"
+	"github.com/yatbfi/cool/internal/domain/usecase"
+	"github.com/yatbfi/cool/internal/pkg/common"
+	"github.com/yatbfi/cool/internal/pkg/table"
+)
+
+// ReviewShowCmd displays a single review request and its timeline
+type ReviewShowCmd struct {
+	*baseCmd
+	reviewUc usecase.Review
+	timeline bool
+	messages bool
+}
+
+// NewReviewShowCmd creates a new review show command
+func NewReviewShowCmd(reviewUc usecase.Review) *ReviewShowCmd {
+	cmd := &ReviewShowCmd{
+		reviewUc: reviewUc,
+	}
+	cmd.baseCmd = newBaseCommand(&cobra.Command{
+		Use:   "show <review-id>",
+		Short: "Show review request details and timeline",
+		Long: `Show the details of a review request.
+
+Use --timeline to list every recorded event (submission, approvals, rejections,
+forwarding, ...) together with how long the request spent in each stage.
+Add --messages to also print the chat messages that were sent.
+
+Examples:
+  cool review show abc123
+  cool review show abc123 --timeline
+  cool review show abc123 --timeline --messages
+  cool review show abc123 -o json    Details and timeline as JSON`,
+		Args:        cobra.ExactArgs(1),
+		Annotations: outputFormats(outputJSON, outputYAML),
+		RunE:        cmd.run,
+	})
+	cmd.initFlags()
+	return cmd
+}
+
+// reviewShowOutput is the document printed by review show with --output json|yaml
+type reviewShowOutput struct {
+	reviewOutputItem
+	Timeline []entity.ReviewEvent `json:"timeline"`
+}
+
+func (c *ReviewShowCmd) run(cmd *cobra.Command, args []string) error {
+	format, err := outputFormat(cmd)
+	if err != nil {
+		return err
+	}
+
+	entry, err := c.reviewUc.GetHistoryByID(cmd.Context(), args[0])
+	if err != nil {
+		return fmt.Errorf("get review: %w", err)
+	}
+
+	if isStructuredOutput(format) {
+		return printStructured(format, reviewShowOutput{
+			reviewOutputItem: newReviewOutputItem(entry),
+			Timeline:         entry.Timeline(),
+		})
+	}
+
+	printReviewDetails(entry)
+
+	if c.timeline || c.messages {
+		c.displayTimeline(entry)
+	}
+
+	return nil
+}
+
+func (c *ReviewShowCmd) displayTimeline(entry *usecase.ReviewHistoryEntry) {
+	events := entry.Timeline()
+
+	fmt.Println("🕒 Timeline")
+	tbl := table.NewTable("When", "Event", "Actor", "Status", "Stage Duration", "Note")
+	for i, event := range events {
+		// A stage lasts until the next event, or until now while the review is still open.
+		// Reminders and Jira updates happen within a stage and have no duration of their own.
+		duration := "-"
+		next := nextStageEvent(events, i)
+		switch {
+		case !event.Type.ChangesStage():
+		case next < len(events):
+			duration = common.FormatDuration(events[next].At.Sub(event.At))
+		case !entry.CurrentStatus().IsTerminal():
+			duration = common.FormatDuration(time.Since(event.At)) + " (ongoing)"
+		}
+
+		actor := event.Actor
+		if actor == "" {
+			actor = "-"
+		}
+
+		tbl.AddRow(
+			event.At.Format("2006-01-02 15:04"),
+			string(event.Type),
+			actor,
+			string(event.Status),
+			duration,
+			strings.ReplaceAll(event.Note, "\n", " "),
+		)
+	}
+	tbl.Print()
+	fmt.Println()
+
+	if len(events) > 1 {
+		fmt.Printf("Total elapsed: %s\n", common.FormatDuration(events[len(events)-1].At.Sub(events[0].At)))
+		fmt.Println()
+	}
+
+	if !c.messages {
+		return
+	}
+
+	for _, event := range events {
+		if event.Message == "" {
+			continue
+		}
+		fmt.Printf("💬 Message sent on %s (%s):\n", string(event.Type), event.At.Format("2006-01-02 15:04:05"))
+		for _, line := range strings.Split(strings.TrimRight(event.Message, "\n"), "\n") {
+			fmt.Printf("   │ %s\n", line)
+		}
+		fmt.Println()
+	}
+}
+
+func (c *ReviewShowCmd) initFlags() {
+	flags := c.cmd.Flags()
+	flags.BoolVarP(&c.timeline, "timeline", "t", false, "Show the event timeline with stage durations")
+	flags.BoolVar(&c.messages, "messages", false, "Include the chat messages that were sent (implies --timeline)")
+}
+
+// nextStageEvent returns the index of the first event after i that changes the stage
+func nextStageEvent(events []entity.ReviewEvent, i int) int {
+	for j := i + 1; j < len(events); j++ {
+		if events[j].Type.ChangesStage() {
+			return j
+		}
+	}
+	return len(events)
+}
@@ -0,0 +1,206 @@
+package cmd
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/yatbfi/cool/internal/domain/usecase"
+	"github.com/yatbfi/cool/internal/pkg/common"
+	"github.com/yatbfi/cool/internal/pkg/table"
+)
+
+// ReviewStatsCmd displays review turnaround analytics
+type ReviewStatsCmd struct {
+	*baseCmd
+	reviewUc usecase.Review
+	since    string
+	until    string
+	format   string
+}
+
+// NewReviewStatsCmd creates a new review stats command
+func NewReviewStatsCmd(reviewUc usecase.Review) *ReviewStatsCmd {
+	cmd := &ReviewStatsCmd{
+		reviewUc: reviewUc,
+	}
+	cmd.baseCmd = newBaseCommand(&cobra.Command{
+		Use:   "stats",
+		Short: "Show review turnaround statistics",
+		Long: `Show statistics about submitted review requests:
+- requests submitted per week
+- median and p90 time from submission to collaboration forward, per priority
+- age of the pending backlog (not forwarded yet)
+- top Jira projects and repositories, parsed from the links
+
+--since and --until accept a date (2025-11-01), an RFC 3339 timestamp or a
+relative age (7d, 2w, 36h). A date for --until includes that whole day.
+
+Examples:
+  cool review stats
+  cool review stats --since 30d
+  cool review stats --since 2025-10-01 --until 2025-10-31 -o json`,
+		Annotations: outputFormats(outputJSON, outputYAML),
+		RunE:        cmd.run,
+	})
+	cmd.initFlags()
+	return cmd
+}
+
+func (c *ReviewStatsCmd) run(cmd *cobra.Command, _ []string) error {
+	opts, err := c.options(time.Now())
+	if err != nil {
+		return err
+	}
+
+	format, err := c.outputFormat(cmd)
+	if err != nil {
+		return err
+	}
+
+	stats, err := c.reviewUc.GetStats(cmd.Context(), opts)
+	if err != nil {
+		return fmt.Errorf("get stats: %w", err)
+	}
+
+	if isStructuredOutput(format) {
+		return printStructured(format, stats)
+	}
+	c.displayStats(stats)
+	return nil
+}
+
+// outputFormat returns the --output format, honouring the deprecated --format flag
+func (c *ReviewStatsCmd) outputFormat(cmd *cobra.Command) (string, error) {
+	format, err := outputFormat(cmd)
+	if err != nil || !cmd.Flags().Changed("format") {
+		return format, err
+	}
+	if cmd.Flags().Changed("output") {
+		return "", fmt.Errorf("--format and --output cannot be used together")
+	}
+
+	switch c.format {
+	case "json":
+		return outputJSON, nil
+	case "table":
+		return outputText, nil
+	default:
+		return "", fmt.Errorf("invalid format %q (must be table or json)", c.format)
+	}
+}
+
+func (c *ReviewStatsCmd) options(now time.Time) (usecase.StatsOptions, error) {
+	since, until, err := parseTimeRange(c.since, c.until, now)
+	if err != nil {
+		return usecase.StatsOptions{}, err
+	}
+	return usecase.StatsOptions{Since: since, Until: until}, nil
+}
+
+// parseTimeRange parses the --since and --until flags; empty values leave the bound open
+func parseTimeRange(sinceFlag, untilFlag string, now time.Time) (since, until *time.Time, err error) {
+	if sinceFlag != "" {
+		t, err := common.ParseTimeExpr(sinceFlag, now, false)
+		if err != nil {
+			return nil, nil, fmt.Errorf("--since: %w", err)
+		}
+		since = &t
+	}
+	if untilFlag != "" {
+		t, err := common.ParseTimeExpr(untilFlag, now, true)
+		if err != nil {
+			return nil, nil, fmt.Errorf("--until: %w", err)
+		}
+		until = &t
+	}
+	return since, until, nil
+}
+
+func (c *ReviewStatsCmd) displayStats(stats *usecase.ReviewStats) {
+	fmt.Println()
+	fmt.Println("📊 Review Statistics")
+	fmt.Println("====================")
+	fmt.Printf("Period: %s\n", formatStatsPeriod(stats))
+	fmt.Printf("Requests: %d\n", stats.Total)
+
+	if stats.Total == 0 {
+		fmt.Println()
+		fmt.Println("📝 No review requests in this period")
+		fmt.Println()
+		return
+	}
+
+	fmt.Println()
+	fmt.Println("📅 Requests per Week")
+	weekly := table.NewTable("Week", "Starting", "Requests", "")
+	for _, w := range stats.Weekly {
+		weekly.AddRow(w.Week, w.WeekStart.Format("2006-01-02"), fmt.Sprintf("%d", w.Count), strings.Repeat("█", w.Count))
+	}
+	weekly.Print()
+
+	fmt.Println()
+	fmt.Println("⏱️  Submit → Collaboration Forward")
+	if len(stats.Turnaround) == 0 {
+		fmt.Println("   No request was forwarded in this period")
+	} else {
+		turnaround := table.NewTable("Priority", "Forwarded", "Median", "P90")
+		for _, t := range stats.Turnaround {
+			turnaround.AddRow(t.Priority, fmt.Sprintf("%d", t.Count), formatSeconds(t.MedianSeconds), formatSeconds(t.P90Seconds))
+		}
+		turnaround.Print()
+	}
+
+	fmt.Println()
+	fmt.Println("📥 Pending Backlog")
+	fmt.Printf("   Requests: %d\n", stats.Backlog.Count)
+	if stats.Backlog.Count > 0 {
+		fmt.Printf("   Median age: %s\n", formatSeconds(stats.Backlog.MedianSeconds))
+		fmt.Printf("   Oldest: %s (%s)\n", formatSeconds(stats.Backlog.OldestSeconds), usecase.ShortID(stats.Backlog.Oldest))
+		for _, bucket := range stats.Backlog.Buckets {
+			fmt.Printf("   %-5s %3d %s\n", bucket.Name, bucket.Count, strings.Repeat("█", bucket.Count))
+		}
+	}
+
+	displayTopCounts("🎫 Top Jira Projects", "Project", stats.TopJiraProjects)
+	displayTopCounts("📦 Top Repositories", "Repository", stats.TopRepositories)
+	fmt.Println()
+}
+
+func displayTopCounts(title, label string, counts []usecase.NamedCount) {
+	if len(counts) == 0 {
+		return
+	}
+
+	fmt.Println()
+	fmt.Println(title)
+	tbl := table.NewTable(label, "Requests")
+	for _, c := range counts {
+		tbl.AddRow(c.Name, fmt.Sprintf("%d", c.Count))
+	}
+	tbl.Print()
+}
+
+func formatStatsPeriod(stats *usecase.ReviewStats) string {
+	from, to := "beginning", "now"
+	if stats.Since != nil {
+		from = stats.Since.Format("2006-01-02 15:04")
+	}
+	if stats.Until != nil {
+		to = stats.Until.Format("2006-01-02 15:04")
+	}
+	return from + " → " + to
+}
+
+func formatSeconds(seconds int64) string {
+	return common.FormatDuration(time.Duration(seconds) * time.Second)
+}
+
+func (c *ReviewStatsCmd) initFlags() {
+	flags := c.cmd.Flags()
+	flags.StringVar(&c.since, "since", "", "Only requests submitted at or after this time (e.g. 2025-11-01, 30d)")
+	flags.StringVar(&c.until, "until", "", "Only requests submitted before this time (a date includes the whole day)")
+	flags.StringVar(&c.format, "format", "table", "Output format: table or json")
+	_ = flags.MarkDeprecated("format", "use --output json instead")
+}
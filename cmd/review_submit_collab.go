@@ -16,6 +16,7 @@ type ReviewSubmitCollabCmd struct {
 	reviewUc usecase.Review
 	listOnly bool
 	pending  bool
+	force    bool
 }
 
 // NewReviewSubmitCollabCmd creates a new review submit-collab command
@@ -28,14 +29,18 @@ func NewReviewSubmitCollabCmd(reviewUc usecase.Review) *ReviewSubmitCollabCmd {
 		Short: "Submit approved review to head architect",
 		Long: `Submit an approved review request to head architect via collaboration channel.
 
-Use this command after your review has been approved by tech lead.
-The request will be forwarded to the head architect for final approval.
+Use this command after your review has been approved by tech lead
+(cool review approve <id>). The request will be forwarded to the head architect
+for final approval. Use --force to forward a request that is not approved yet.
 
 Examples:
   cool review submit-collab abc123            Submit specific review
+  cool review submit-collab abc123 --force    Submit without tech lead approval
   cool review submit-collab --list            Show all reviews with status
-  cool review submit-collab --list --pending  Show only pending reviews`,
-		RunE: cmd.run,
+  cool review submit-collab --list --pending  Show only pending reviews
+  cool review submit-collab --list -o wide    Show full IDs and titles`,
+		Annotations: outputFormats(outputJSON, outputYAML, outputWide),
+		RunE:        cmd.run,
 	})
 	cmd.initFlags()
 	return cmd
@@ -44,9 +49,17 @@ Examples:
 func (c *ReviewSubmitCollabCmd) run(cmd *cobra.Command, args []string) error {
 	ctx := cmd.Context()
 
+	format, err := outputFormat(cmd)
+	if err != nil {
+		return err
+	}
+
 	// If list flag is set, show history table
 	if c.listOnly {
-		return c.showHistoryTable(ctx)
+		return c.showHistoryTable(ctx, format)
+	}
+	if format != outputText {
+		return fmt.Errorf("--output is only supported with --list")
 	}
 
 	// Validate arguments
@@ -54,17 +67,20 @@ func (c *ReviewSubmitCollabCmd) run(cmd *cobra.Command, args []string) error {
 		return fmt.Errorf("review ID is required\nUsage: cool review submit-collab <review-id>\nTip: Use --list to see available reviews")
 	}
 
-	reviewID := args[0]
-
-	// Get review entry
-	entry, err := c.reviewUc.GetHistoryByID(ctx, reviewID)
+	// Get review entry (the ID may be abbreviated)
+	entry, err := c.reviewUc.GetHistoryByID(ctx, args[0])
 	if err != nil {
 		return fmt.Errorf("get review: %w", err)
 	}
+	reviewID := entry.ID
 
 	// Display review details
 	c.displayReviewDetails(entry)
 
+	if err := usecase.ValidateForward(entry, c.force); err != nil {
+		return err
+	}
+
 	// Confirm submission
 	if !c.confirmSubmission() {
 		fmt.Println("\n❌ Submission cancelled")
@@ -73,7 +89,7 @@ func (c *ReviewSubmitCollabCmd) run(cmd *cobra.Command, args []string) error {
 
 	// Submit to collaboration
 	fmt.Println("\n⏳ Submitting to collaboration channel...")
-	if err := c.reviewUc.SubmitToCollaboration(ctx, reviewID); err != nil {
+	if err := c.reviewUc.SubmitToCollaboration(ctx, reviewID, c.force); err != nil {
 		return fmt.Errorf("submit to collaboration: %w", err)
 	}
 
@@ -81,6 +97,10 @@ func (c *ReviewSubmitCollabCmd) run(cmd *cobra.Command, args []string) error {
 	fmt.Println()
 	fmt.Println("✅ Successfully submitted to head architect!")
 	fmt.Println()
+	printUndeliveredNotifications(ctx, c.reviewUc, reviewID)
+	if forwarded, err := c.reviewUc.GetHistoryByID(ctx, reviewID); err == nil {
+		printJiraUpdates(forwarded)
+	}
 	fmt.Println("💡 Your review request has been forwarded to the collaboration channel.")
 	fmt.Println("   The head architect will review and provide approval.")
 	fmt.Println()
@@ -88,7 +108,7 @@ func (c *ReviewSubmitCollabCmd) run(cmd *cobra.Command, args []string) error {
 	return nil
 }
 
-func (c *ReviewSubmitCollabCmd) showHistoryTable(ctx context.Context) error {
+func (c *ReviewSubmitCollabCmd) showHistoryTable(ctx context.Context, format string) error {
 	filter := usecase.HistoryFilterAll
 	if c.pending {
 		filter = usecase.HistoryFilterPending
@@ -99,6 +119,10 @@ func (c *ReviewSubmitCollabCmd) showHistoryTable(ctx context.Context) error {
 		return fmt.Errorf("get histories: %w", err)
 	}
 
+	if isStructuredOutput(format) {
+		return printReviewList(format, histories)
+	}
+
 	if len(histories) == 0 {
 		fmt.Println()
 		fmt.Println("📝 No review history found")
@@ -109,34 +133,28 @@ func (c *ReviewSubmitCollabCmd) showHistoryTable(ctx context.Context) error {
 		return nil
 	}
 
-	c.displayHistoriesTable(histories)
+	c.displayHistoriesTable(histories, format)
 	return nil
 }
 
-func (c *ReviewSubmitCollabCmd) displayHistoriesTable(histories []*usecase.ReviewHistoryEntry) {
-	tbl := table.NewTable("ID", "Title", "Priority", "PRs", "Jira", "Submitted", "Collab Status")
+func (c *ReviewSubmitCollabCmd) displayHistoriesTable(histories []*usecase.ReviewHistoryEntry, format string) {
+	tbl := table.NewTable("ID", "Title", "Priority", "PRs", "Jira", "Submitted", "Status")
 
 	for _, entry := range histories {
-		id := entry.ID
-		if len(id) > 8 {
-			id = id[:8]
+		id := usecase.ShortID(entry.ID)
+		if format == outputWide {
+			id = entry.ID
 		}
 
-		title := entry.Title
-		if len(title) > 40 {
-			title = title[:37] + "..."
-		}
+		title := truncateTitle(entry.Title, format)
 
 		prCount := fmt.Sprintf("%d", len(entry.ReviewLinks))
 		jiraCount := fmt.Sprintf("%d", len(entry.JiraLinks))
 		submittedAt := entry.SubmittedAt.Format("2006-01-02 15:04")
 
-		collabStatus := "⏳ Pending"
-		if entry.SubmittedToCollab {
-			collabStatus = "✅ Submitted"
-		}
+		status := formatReviewStatus(entry.CurrentStatus())
 
-		tbl.AddRow(id, title, entry.Priority, prCount, jiraCount, submittedAt, collabStatus)
+		tbl.AddRow(id, title, entry.Priority, prCount, jiraCount, submittedAt, status)
 	}
 
 	tbl.Print()
@@ -147,34 +165,7 @@ func (c *ReviewSubmitCollabCmd) displayHistoriesTable(histories []*usecase.Revie
 }
 
 func (c *ReviewSubmitCollabCmd) displayReviewDetails(entry *usecase.ReviewHistoryEntry) {
-	fmt.Println()
-	fmt.Println("📋 Review Request Details")
-	fmt.Println("=========================")
-	fmt.Println()
-	fmt.Printf("ID: %s\n", entry.ID)
-	fmt.Printf("Title: %s\n", entry.Title)
-	fmt.Printf("Priority: %s\n", entry.Priority)
-	fmt.Printf("Description: %s\n", entry.Description)
-	fmt.Println()
-	fmt.Printf("Submitted by: %s (%s)\n", entry.SubmittedBy, entry.SubmittedByEmail)
-	fmt.Printf("Submitted at: %s\n", entry.SubmittedAt.Format("2006-01-02 15:04:05"))
-	fmt.Println()
-
-	if len(entry.ReviewLinks) > 0 {
-		fmt.Println("Pull Requests:")
-		for _, link := range entry.ReviewLinks {
-			fmt.Printf("  • %s\n", link)
-		}
-		fmt.Println()
-	}
-
-	if len(entry.JiraLinks) > 0 {
-		fmt.Println("Jira Tickets:")
-		for _, link := range entry.JiraLinks {
-			fmt.Printf("  • %s\n", link)
-		}
-		fmt.Println()
-	}
+	printReviewDetails(entry)
 
 	if entry.SubmittedToCollab {
 		fmt.Println("⚠️  This review has already been submitted to collaboration.")
@@ -200,4 +191,5 @@ func (c *ReviewSubmitCollabCmd) initFlags() {
 	flags := c.cmd.Flags()
 	flags.BoolVarP(&c.listOnly, "list", "l", false, "Show review history table")
 	flags.BoolVar(&c.pending, "pending", false, "Show only pending reviews (use with --list)")
+	flags.BoolVar(&c.force, "force", false, "Forward even if the review is not approved by tech lead")
 }
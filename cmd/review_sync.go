@@ -0,0 +1,158 @@
+package cmd
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/yatbfi/cool/internal/domain/usecase"
+	"github.com/yatbfi/cool/internal/pkg/common"
+)
+
+// ReviewSyncCmd reconciles the review history with the state of the pull requests
+type ReviewSyncCmd struct {
+	*baseCmd
+	reviewUc usecase.Review
+	dryRun   bool
+}
+
+// NewReviewSyncCmd creates a new review sync command
+func NewReviewSyncCmd(reviewUc usecase.Review) *ReviewSyncCmd {
+	cmd := &ReviewSyncCmd{
+		reviewUc: reviewUc,
+	}
+	cmd.baseCmd = newBaseCommand(&cobra.Command{
+		Use:   "sync",
+		Short: "Mark requests merged or closed from their pull requests",
+		Long: `Look up the pull requests of every open review request on GitHub or GitLab
+and update the history with what changed.
+
+A request whose pull requests are all merged (or closed, with at least one
+merged) is marked merged; one whose pull requests are all closed without
+merging is marked closed. Other requests only get their pull request details
+refreshed. Drafts and finished requests are left alone.
+
+The sync stops when the code host rate limit is hit; the requests checked so
+far are saved and the rest can be synced again once the limit resets.
+
+Examples:
+  cool review sync --dry-run    Show what would change without saving
+  cool review sync              Update the history`,
+		RunE: cmd.run,
+	})
+	cmd.initFlags()
+	return cmd
+}
+
+func (c *ReviewSyncCmd) run(cmd *cobra.Command, _ []string) error {
+	result, err := c.reviewUc.SyncReviews(cmd.Context(), usecase.SyncOptions{DryRun: c.dryRun})
+	if result == nil {
+		return fmt.Errorf("failed to sync reviews: %w", err)
+	}
+
+	fmt.Println()
+	if len(result.Reviews) == 0 {
+		fmt.Println("✅ No open review requests with pull requests to sync")
+		fmt.Println()
+		return err
+	}
+
+	for _, review := range result.Reviews {
+		if review.Outcome == usecase.SyncUnchanged || review.Outcome == usecase.SyncNotChecked {
+			continue
+		}
+		printSyncedReview(review)
+	}
+
+	fmt.Printf("Checked %d review(s): %d merged, %d closed, %d updated, %d unchanged, %d skipped\n",
+		len(result.Reviews)-result.Count(usecase.SyncNotChecked),
+		result.Count(usecase.SyncMerged),
+		result.Count(usecase.SyncClosed),
+		result.Count(usecase.SyncUpdated),
+		result.Count(usecase.SyncUnchanged),
+		result.Count(usecase.SyncSkipped),
+	)
+	fmt.Println()
+	if err != nil {
+		return fmt.Errorf("failed to sync reviews: %w", err)
+	}
+
+	if !result.RateLimitReset.IsZero() {
+		fmt.Printf("⚠️  Code host rate limit reached, %d review(s) were not checked.\n", result.Count(usecase.SyncNotChecked))
+		fmt.Printf("   Run 'cool review sync' again after %s (in %s).\n",
+			result.RateLimitReset.Local().Format("2006-01-02 15:04"),
+			common.FormatDuration(time.Until(result.RateLimitReset)))
+		fmt.Println()
+	}
+	if c.dryRun {
+		fmt.Println("💡 Dry run, nothing was saved. Run without --dry-run to update the history.")
+		fmt.Println()
+	}
+
+	return nil
+}
+
+// printSyncedReview prints what changed for a review request
+func printSyncedReview(review *usecase.SyncedReview) {
+	fmt.Printf("%s %s  %s\n", formatSyncOutcome(review.Outcome), usecase.ShortID(review.Entry.ID), review.Entry.Title)
+	if review.To != review.From {
+		fmt.Printf("   status: %s → %s\n", formatReviewStatus(review.From), formatReviewStatus(review.To))
+	}
+
+	for _, change := range review.PullRequests {
+		name := change.Link
+		if info := change.New; info != nil && info.Repository != "" {
+			name = fmt.Sprintf("%s#%d", info.Repository, info.Number)
+		}
+
+		switch {
+		case change.Err != nil:
+			if review.Outcome != usecase.SyncSkipped {
+				fmt.Printf("   %s: ⚠️  %v\n", name, change.Err)
+			}
+		case change.Old == nil:
+			fmt.Printf("   %s: %s\n", name, change.New.Summary())
+		case change.Changed():
+			if change.Old.StateLabel() != change.New.StateLabel() {
+				fmt.Printf("   %s: %s → %s\n", name, change.Old.StateLabel(), change.New.StateLabel())
+			}
+			if change.Old.CIStatus != change.New.CIStatus {
+				fmt.Printf("   %s: %s → %s\n", name, formatCILabel(change.Old), formatCILabel(change.New))
+			}
+		}
+	}
+
+	if review.Reason != "" {
+		fmt.Printf("   %s\n", review.Reason)
+	}
+	fmt.Println()
+}
+
+// formatSyncOutcome returns a display label for a sync outcome
+func formatSyncOutcome(outcome string) string {
+	switch outcome {
+	case usecase.SyncMerged:
+		return "🟣 Merged "
+	case usecase.SyncClosed:
+		return "🚫 Closed "
+	case usecase.SyncUpdated:
+		return "🔄 Updated"
+	case usecase.SyncSkipped:
+		return "⏭️  Skipped"
+	default:
+		return "-"
+	}
+}
+
+// formatCILabel returns the CI label of a pull request, or a placeholder when no checks were reported
+func formatCILabel(info *usecase.PullRequestInfo) string {
+	if label := info.CILabel(); label != "" {
+		return label
+	}
+	return "no CI"
+}
+
+func (c *ReviewSyncCmd) initFlags() {
+	flags := c.cmd.Flags()
+	flags.BoolVar(&c.dryRun, "dry-run", false, "Show what would change without saving")
+}
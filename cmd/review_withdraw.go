@@ -0,0 +1,51 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"github.com/yatbfi/cool/internal/domain/usecase"
+)
+
+// ReviewWithdrawCmd withdraws a review request
+type ReviewWithdrawCmd struct {
+	*baseCmd
+	reviewUc usecase.Review
+	note     string
+}
+
+// NewReviewWithdrawCmd creates a new review withdraw command
+func NewReviewWithdrawCmd(reviewUc usecase.Review) *ReviewWithdrawCmd {
+	cmd := &ReviewWithdrawCmd{
+		reviewUc: reviewUc,
+	}
+	cmd.baseCmd = newBaseCommand(&cobra.Command{
+		Use:   "withdraw <review-id>",
+		Short: "Withdraw a review request",
+		Long: `Withdraw a review request that is no longer needed.
+
+Withdrawn requests are kept in history but cannot be approved or forwarded.
+
+Examples:
+  cool review withdraw abc123 --note "Superseded by another PR"`,
+		Args: cobra.ExactArgs(1),
+		RunE: cmd.run,
+	})
+	cmd.initFlags()
+	return cmd
+}
+
+func (c *ReviewWithdrawCmd) run(cmd *cobra.Command, args []string) error {
+	entry, err := c.reviewUc.WithdrawReview(cmd.Context(), args[0], c.note)
+	if err != nil {
+		return fmt.Errorf("withdraw review: %w", err)
+	}
+
+	printReviewStatusChange(entry)
+	printUndeliveredNotifications(cmd.Context(), c.reviewUc, entry.ID)
+	return nil
+}
+
+func (c *ReviewWithdrawCmd) initFlags() {
+	c.cmd.Flags().StringVar(&c.note, "note", "", "Reason for withdrawing")
+}
@@ -1,7 +1,10 @@
 package cmd
 
 import (
+	"fmt"
+
 	"github.com/spf13/cobra"
+	"github.com/yatbfi/cool/config"
 	"github.com/yatbfi/cool/internal/domain/usecase"
 	infraRepo "github.com/yatbfi/cool/internal/infrastructure/repository"
 )
@@ -12,12 +15,16 @@ type RootCmd struct {
 }
 
 // NewRootCommand creates the root command with all subcommands
-func NewRootCommand() *RootCmd {
+func NewRootCommand() (*RootCmd, error) {
 	rootCmd := &RootCmd{}
 	rootCmd.baseCmd = newBaseCommand(&cobra.Command{
 		Use:   "cool",
 		Short: "Cool CLI - Developer tools",
+		// Errors are printed once by main; usage is only useful for flag parsing errors
+		SilenceUsage:  true,
+		SilenceErrors: true,
 	})
+	rootCmd.cmd.PersistentFlags().StringP("output", "o", "", "Output format of listings: json, yaml or wide")
 
 	// Initialize repositories and usecase
 	historyRepo, err := infraRepo.NewReviewHistoryRepository()
@@ -27,7 +34,21 @@ func NewRootCommand() *RootCmd {
 	}
 
 	gchatUc := usecase.NewGChatUsecase()
-	reviewUc := usecase.NewReviewUsecase(historyRepo, gchatUc)
+	notifiers := map[string]usecase.Notifier{
+		config.ChannelTypeGChat:   usecase.NewGChatNotifier(gchatUc),
+		config.ChannelTypeSlack:   usecase.NewSlackNotifier(),
+		config.ChannelTypeTeams:   usecase.NewTeamsNotifier(),
+		config.ChannelTypeWebhook: usecase.NewWebhookNotifier(),
+	}
+	// Every notification goes through the outbox, so there is no running without it
+	outboxRepo, err := infraRepo.NewOutboxRepository()
+	if err != nil {
+		return nil, fmt.Errorf("init outbox: %w", err)
+	}
+	outboxUc := usecase.NewOutboxUsecase(outboxRepo, historyRepo, notifiers)
+	codeHostUc := usecase.NewCodeHostUsecase(nil, config.GetConfig())
+	jiraUc := usecase.NewJiraUsecase(nil, config.GetConfig())
+	reviewUc := usecase.NewReviewUsecase(historyRepo, gchatUc, outboxUc, codeHostUc, jiraUc)
 
 	// Add subcommands
 	setupCmd := NewSetupCmd()
@@ -40,9 +61,39 @@ func NewRootCommand() *RootCmd {
 
 	reviewCmd := NewReviewCmd()
 	reviewCmd.Cmd().AddCommand(
-		NewReviewRequestCmd(reviewUc).Cmd(),
+		NewReviewRequestCmd(reviewUc, codeHostUc, jiraUc).Cmd(),
 		NewReviewHistoriesCmd(reviewUc).Cmd(),
+		NewReviewShowCmd(reviewUc).Cmd(),
+		NewReviewDraftsCmd(reviewUc).Cmd(),
 		NewReviewSubmitCollabCmd(reviewUc).Cmd(),
+		NewReviewAmendCmd(reviewUc).Cmd(),
+		NewReviewApproveCmd(reviewUc).Cmd(),
+		NewReviewRejectCmd(reviewUc).Cmd(),
+		NewReviewWithdrawCmd(reviewUc).Cmd(),
+		NewReviewRemindCmd(reviewUc).Cmd(),
+		NewReviewSyncCmd(reviewUc).Cmd(),
+		NewReviewStatsCmd(reviewUc).Cmd(),
+		NewReviewExportCmd(reviewUc).Cmd(),
+		NewReviewImportCmd(reviewUc).Cmd(),
+	)
+
+	outboxCmd := NewOutboxCmd()
+	outboxCmd.Cmd().AddCommand(
+		NewOutboxListCmd(outboxUc).Cmd(),
+		NewOutboxFlushCmd(outboxUc).Cmd(),
+		NewOutboxDropCmd(outboxUc).Cmd(),
+	)
+
+	templateCmd := NewTemplateCmd()
+	templateCmd.Cmd().AddCommand(
+		NewTemplatePreviewCmd(reviewUc).Cmd(),
+	)
+
+	teamCmd := NewTeamCmd()
+	teamCmd.Cmd().AddCommand(
+		NewTeamListCmd().Cmd(),
+		NewTeamAddCmd().Cmd(),
+		NewTeamRemoveCmd().Cmd(),
 	)
 
 	configCmd := NewConfigCmd()
@@ -53,17 +104,23 @@ func NewRootCommand() *RootCmd {
 	rootCmd.cmd.AddCommand(
 		setupCmd.Cmd(),
 		reviewCmd.Cmd(),
+		outboxCmd.Cmd(),
+		templateCmd.Cmd(),
+		teamCmd.Cmd(),
 		configCmd.Cmd(),
 		NewRunCmd().Cmd(),
 		NewUpdateCmd().Cmd(),
 		NewCompletionCmd().Cmd(),
 	)
 
-	return rootCmd
+	return rootCmd, nil
 }
 
 // Execute executes the root command
 func Execute() error {
-	rootCmd := NewRootCommand()
+	rootCmd, err := NewRootCommand()
+	if err != nil {
+		return err
+	}
 	return rootCmd.cmd.Execute()
 }
@@ -26,7 +26,9 @@ func (c *SetupCmd) run(cmd *cobra.Command, args []string) error {
 	cfg := config.GetConfig()
 
 	needsEmailSetup := cfg.UserName == "" || cfg.UserEmail == ""
-	needsWebhookSetup := cfg.GChatReviewWebhookURL == "" || cfg.GChatCollabWebhookURL == ""
+	_, reviewErr := cfg.StageChannels(config.StageReview)
+	_, collabErr := cfg.StageChannels(config.StageCollab)
+	needsWebhookSetup := reviewErr != nil || collabErr != nil
 
 	// If everything is already configured
 	if !needsEmailSetup && !needsWebhookSetup {
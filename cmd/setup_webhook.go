@@ -2,6 +2,7 @@ package cmd
 
 import (
 	"fmt"
+	"slices"
 
 	"github.com/manifoldco/promptui"
 	"github.com/spf13/cobra"
@@ -45,8 +46,21 @@ func (c *SetupWebhookCmd) run(cmd *cobra.Command, args []string) error {
 		return err
 	}
 
+	// Ask for message format
+	formats := []string{config.MessageFormatCards, config.MessageFormatText}
+	formatPrompt := promptui.Select{
+		Label:     "GChat Message Format (cards: rich card with PR/Jira buttons, text: plain text)",
+		Items:     formats,
+		CursorPos: max(slices.Index(formats, cfg.GChatMessageFormat), 0),
+	}
+	_, messageFormat, err := formatPrompt.Run()
+	if err != nil {
+		return err
+	}
+
 	cfg.GChatReviewWebhookURL = reviewWebhookURL
 	cfg.GChatCollabWebhookURL = collabWebhookURL
+	cfg.GChatMessageFormat = messageFormat
 
 	if err = config.SaveLocalConfig(cfg); err != nil {
 		return err
@@ -55,5 +69,6 @@ func (c *SetupWebhookCmd) run(cmd *cobra.Command, args []string) error {
 	fmt.Printf("\n✅ Webhook setup complete!\n")
 	fmt.Printf("GChat Review Webhook URL : %s\n", reviewWebhookURL)
 	fmt.Printf("GChat Collab Webhook URL : %s\n", collabWebhookURL)
+	fmt.Printf("GChat Message Format     : %s\n", messageFormat)
 	return nil
 }
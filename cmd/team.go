@@ -0,0 +1,47 @@
+package cmd
+
+import (
+	"github.com/spf13/cobra"
+	"github.com/yatbfi/cool/config"
+)
+
+// TeamCmd is the parent command for team roster operations
+type TeamCmd struct {
+	*baseCmd
+}
+
+// NewTeamCmd creates a new team command
+func NewTeamCmd() *TeamCmd {
+	cmd := &TeamCmd{}
+	cmd.baseCmd = newBaseCommand(&cobra.Command{
+		Use:   "team",
+		Short: "Manage the team roster used for reviewer mentions",
+		Long: `Manage the team roster stored in ~/.cool-cli/config.json.
+
+Each member has a name, email, Google Chat user ID, role (developer, tech-lead
+or architect) and squad. New review requests mention the tech leads of the
+requester's squad, forwarded requests its architects, using <users/ID>. A
+route with a "squad" picks the squad for the requests it matches. Squads
+without a tech lead or architect fall back to the members without a squad.
+
+This command provides subcommands to:
+- List the roster
+- Add or update a member
+- Remove members`,
+	})
+	return cmd
+}
+
+// formatTeamRole returns a display label for a team role
+func formatTeamRole(role string) string {
+	labels := map[string]string{
+		config.RoleDeveloper: "💻 Developer",
+		config.RoleTechLead:  "🧭 Tech Lead",
+		config.RoleArchitect: "🏛️ Architect",
+	}
+
+	if label, ok := labels[role]; ok {
+		return label
+	}
+	return role
+}
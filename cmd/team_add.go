@@ -0,0 +1,144 @@
+package cmd
+
+import (
+	"fmt"
+	"slices"
+
+	"github.com/manifoldco/promptui"
+	"github.com/spf13/cobra"
+	"github.com/yatbfi/cool/config"
+)
+
+// TeamAddCmd adds or updates a team member
+type TeamAddCmd struct {
+	*baseCmd
+	member config.TeamMember
+}
+
+// NewTeamAddCmd creates a new team add command
+func NewTeamAddCmd() *TeamAddCmd {
+	cmd := &TeamAddCmd{}
+	cmd.baseCmd = newBaseCommand(&cobra.Command{
+		Use:   "add",
+		Short: "Add or update a team member",
+		Long: `Add a member to the team roster. A member with the same email is updated.
+Fields not given as flags are asked for interactively.
+
+The Google Chat user ID is the number in "users/123456789", shown in the
+member's Google Chat profile link or returned by the Chat API.
+
+Examples:
+  cool team add
+  cool team add --name "Jane Doe" --email jane@example.com --role tech-lead \
+    --squad payments --gchat-id 123456789`,
+		RunE: cmd.run,
+	})
+	cmd.initFlags()
+	return cmd
+}
+
+func (c *TeamAddCmd) run(cmd *cobra.Command, _ []string) error {
+	cfg := config.GetConfig()
+	member := c.member
+
+	// Start from the existing member when updating, so only the given flags change it
+	if existing, ok := cfg.FindTeamMember(member.Email); member.Email != "" && ok {
+		flags := cmd.Flags()
+		if !flags.Changed("name") {
+			member.Name = existing.Name
+		}
+		if !flags.Changed("gchat-id") {
+			member.GChatUserID = existing.GChatUserID
+		}
+		if !flags.Changed("role") {
+			member.Role = existing.Role
+		}
+		if !flags.Changed("squad") {
+			member.Squad = existing.Squad
+		}
+	}
+
+	if err := promptTeamMember(&member); err != nil {
+		return err
+	}
+	if err := member.Normalize(); err != nil {
+		return fmt.Errorf("invalid team member: %w", err)
+	}
+
+	replaced := cfg.SetTeamMember(member)
+	if err := config.SaveLocalConfig(cfg); err != nil {
+		return err
+	}
+
+	fmt.Println()
+	if replaced {
+		fmt.Printf("✅ Updated %s (%s)\n", member.Name, member.Email)
+	} else {
+		fmt.Printf("✅ Added %s (%s)\n", member.Name, member.Email)
+	}
+	fmt.Printf("   Role  : %s\n", formatTeamRole(member.Role))
+	if member.Squad != "" {
+		fmt.Printf("   Squad : %s\n", member.Squad)
+	}
+	if member.GChatUserID != "" {
+		fmt.Printf("   Mention: <users/%s>\n", member.GChatUserID)
+	} else if member.Role != config.RoleDeveloper {
+		fmt.Println("💡 Without a Google Chat user ID the member is mentioned by name only and not notified.")
+	}
+	fmt.Println()
+
+	return nil
+}
+
+// promptTeamMember asks for the required fields that were not given as flags
+func promptTeamMember(member *config.TeamMember) error {
+	if member.Name == "" {
+		name, err := (&promptui.Prompt{Label: "Name"}).Run()
+		if err != nil {
+			return err
+		}
+		member.Name = name
+	}
+	if member.Email == "" {
+		email, err := (&promptui.Prompt{Label: "Email"}).Run()
+		if err != nil {
+			return err
+		}
+		member.Email = email
+	}
+	if member.Role == "" {
+		rolePrompt := promptui.Select{
+			Label: "Role",
+			Items: config.TeamRoles,
+		}
+		_, role, err := rolePrompt.Run()
+		if err != nil {
+			return err
+		}
+		member.Role = role
+
+		squad, err := (&promptui.Prompt{Label: "Squad (optional)", Default: member.Squad}).Run()
+		if err != nil {
+			return err
+		}
+		member.Squad = squad
+
+		if slices.Contains([]string{config.RoleTechLead, config.RoleArchitect}, member.Role) {
+			userID, err := (&promptui.Prompt{Label: "Google Chat user ID (optional)", Default: member.GChatUserID}).Run()
+			if err != nil {
+				return err
+			}
+			member.GChatUserID = userID
+		}
+	}
+	return nil
+}
+
+func (c *TeamAddCmd) initFlags() {
+	flags := c.cmd.Flags()
+	flags.StringVar(&c.member.Name, "name", "", "Full name")
+	flags.StringVar(&c.member.Email, "email", "", "Email address, identifies the member")
+	flags.StringVar(&c.member.Role, "role", "", "Role: developer, tech-lead or architect")
+	flags.StringVar(&c.member.Squad, "squad", "", "Squad the member belongs to")
+	flags.StringVar(&c.member.GChatUserID, "gchat-id", "", "Google Chat user ID (the number in users/123456789)")
+}
@@ -0,0 +1,88 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"github.com/yatbfi/cool/config"
+	"github.com/yatbfi/cool/internal/pkg/table"
+)
+
+// TeamListCmd lists the team roster
+type TeamListCmd struct {
+	*baseCmd
+	squad string
+}
+
+// NewTeamListCmd creates a new team list command
+func NewTeamListCmd() *TeamListCmd {
+	cmd := &TeamListCmd{}
+	cmd.baseCmd = newBaseCommand(&cobra.Command{
+		Use:   "list",
+		Short: "List team members",
+		Long: `List the members of the team roster with their role, squad and Google Chat user ID.
+
+Examples:
+  cool team list                    List everyone
+  cool team list --squad payments   List one squad
+  cool team list --output json      Print the roster as JSON`,
+		Annotations: outputFormats(outputJSON, outputYAML),
+		RunE:        cmd.run,
+	})
+	cmd.initFlags()
+	return cmd
+}
+
+// teamListOutput is the document printed by team list with --output json|yaml
+type teamListOutput struct {
+	Total   int                 `json:"total"`
+	Members []config.TeamMember `json:"members"`
+}
+
+func (c *TeamListCmd) run(cmd *cobra.Command, _ []string) error {
+	format, err := outputFormat(cmd)
+	if err != nil {
+		return err
+	}
+
+	members := []config.TeamMember{}
+	for _, m := range config.GetConfig().Team {
+		if c.squad == "" || m.Squad == c.squad {
+			members = append(members, m)
+		}
+	}
+
+	if isStructuredOutput(format) {
+		return printStructured(format, teamListOutput{Total: len(members), Members: members})
+	}
+
+	if len(members) == 0 {
+		fmt.Println()
+		fmt.Println("👥 No team members found")
+		fmt.Println("   Add one with: cool team add --name \"Jane Doe\" --email jane@example.com --role tech-lead")
+		fmt.Println()
+		return nil
+	}
+
+	tbl := table.NewTable("Name", "Email", "Role", "Squad", "Google Chat User")
+	for _, m := range members {
+		squad, userID := m.Squad, "-"
+		if squad == "" {
+			squad = "-"
+		}
+		if m.GChatUserID != "" {
+			userID = "users/" + m.GChatUserID
+		}
+		tbl.AddRow(m.Name, m.Email, formatTeamRole(m.Role), squad, userID)
+	}
+
+	tbl.Print()
+	fmt.Printf("Total: %d member(s)\n", tbl.RowCount())
+	fmt.Println()
+
+	return nil
+}
+
+func (c *TeamListCmd) initFlags() {
+	c.cmd.Flags().StringVar(&c.squad, "squad", "", "Only list members of this squad")
+}
@@ -0,0 +1,51 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"github.com/yatbfi/cool/config"
+)
+
+// TeamRemoveCmd removes team members
+type TeamRemoveCmd struct {
+	*baseCmd
+}
+
+// NewTeamRemoveCmd creates a new team remove command
+func NewTeamRemoveCmd() *TeamRemoveCmd {
+	cmd := &TeamRemoveCmd{}
+	cmd.baseCmd = newBaseCommand(&cobra.Command{
+		Use:   "remove <email>...",
+		Short: "Remove team members",
+		Long: `Remove members from the team roster by email.
+
+Examples:
+  cool team remove jane@example.com
+  cool team remove jane@example.com john@example.com`,
+		Args: cobra.MinimumNArgs(1),
+		RunE: cmd.run,
+	})
+	return cmd
+}
+
+func (c *TeamRemoveCmd) run(_ *cobra.Command, args []string) error {
+	cfg := config.GetConfig()
+
+	var removed []config.TeamMember
+	for _, email := range args {
+		member, ok := cfg.RemoveTeamMember(email)
+		if !ok {
+			return fmt.Errorf("no team member with email %q", email)
+		}
+		removed = append(removed, member)
+	}
+
+	if err := config.SaveLocalConfig(cfg); err != nil {
+		return err
+	}
+	for _, m := range removed {
+		fmt.Printf("🗑️  Removed %s (%s)\n", m.Name, m.Email)
+	}
+	return nil
+}
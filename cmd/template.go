@@ -0,0 +1,28 @@
+package cmd
+
+import (
+	"github.com/spf13/cobra"
+)
+
+// TemplateCmd is the parent command for message template operations
+type TemplateCmd struct {
+	*baseCmd
+}
+
+// NewTemplateCmd creates a new template command
+func NewTemplateCmd() *TemplateCmd {
+	cmd := &TemplateCmd{}
+	cmd.baseCmd = newBaseCommand(&cobra.Command{
+		Use:   "template",
+		Short: "Manage chat message templates",
+		Long: `Manage the Go text/template files used to render chat messages.
+
+Templates are looked up by name (review_request, collaboration) as <name>.tmpl in:
+  1. .cool-cli/templates/ of the current project (working directory or a parent)
+  2. ~/.cool-cli/templates/
+and fall back to the built-in layout.
+
+A user template replaces the rich card layout with its rendered text.`,
+	})
+	return cmd
+}
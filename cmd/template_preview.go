@@ -0,0 +1,183 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/yatbfi/cool/config"
+	"github.com/yatbfi/cool/internal/domain/entity"
+	"github.com/yatbfi/cool/internal/domain/usecase"
+)
+
+// TemplatePreviewCmd renders a message template
+type TemplatePreviewCmd struct {
+	*baseCmd
+	reviewUc usecase.Review
+	id       string
+	file     string
+	source   bool
+}
+
+// NewTemplatePreviewCmd creates a new template preview command
+func NewTemplatePreviewCmd(reviewUc usecase.Review) *TemplatePreviewCmd {
+	cmd := &TemplatePreviewCmd{
+		reviewUc: reviewUc,
+	}
+	cmd.baseCmd = newBaseCommand(&cobra.Command{
+		Use:       "preview [review_request|collaboration]",
+		Short:     "Render a message template against sample or real data",
+		ValidArgs: usecase.TemplateNames,
+		Args:      cobra.MatchAll(cobra.MaximumNArgs(1), cobra.OnlyValidArgs),
+		Long: `Render a message template and print the resulting message.
+
+The active template (project, user or built-in) is used unless --file is given.
+Data comes from a sample request, or from a real history entry with --id.
+
+Available data: every review history field, e.g. {{.Title}}, {{.Priority}},
+{{.Type}}, {{.Description}}, {{.ReviewLinks}}, {{.JiraLinks}}, {{.SubmittedBy}},
+{{.SubmittedByEmail}}, {{.SubmittedAt}}, {{.ApprovedByTechLead}}, {{.Status}},
+{{.ID}}, plus {{.ForwardedAt}} in the collaboration template.
+{{$.PullRequest <link>}} returns the fetched code host details of a review link
+(nil when none are known), e.g. {{with $.PullRequest .}}{{.Summary}}{{end}}, and
+{{$.JiraIssue <link>}} those of a Jira link, e.g. {{with $.JiraIssue .}}{{.Details}}{{end}}.
+
+Helper functions:
+  prNumber <url>          PR/MR number of a GitHub or GitLab link
+  jiraKey <url>           Ticket key of a Jira link
+  priorityBadge <p>       e.g. "🔴 P0 · Critical"
+  requestType <type>      e.g. "🚑 Hotfix"
+  formatTime <t> [layout] Format a time (default "2006-01-02 15:04:05")
+  join <list> <sep>       Join a list of strings
+  upper, lower, trim      String helpers
+  default <def> <value>   def when value is empty
+  env <name>              COOL_* environment variable, e.g. {{env "COOL_SQUAD"}}
+
+Examples:
+  cool template preview                                 Preview the review request message
+  cool template preview collaboration --id abc123       Render against a real request
+  cool template preview --file ./review_request.tmpl    Try a template before installing it
+  cool template preview --source > ~/.cool-cli/templates/review_request.tmpl`,
+		RunE: cmd.run,
+	})
+	cmd.initFlags()
+	return cmd
+}
+
+func (c *TemplatePreviewCmd) run(cmd *cobra.Command, args []string) error {
+	name := usecase.TemplateReviewRequest
+	if len(args) > 0 {
+		name = args[0]
+	}
+
+	// Print the built-in source as a starting point for a custom template
+	if c.source {
+		text, err := usecase.BuiltinTemplateText(name)
+		if err != nil {
+			return err
+		}
+		fmt.Print(text)
+		return nil
+	}
+
+	tmpl, err := c.loadTemplate(name)
+	if err != nil {
+		return err
+	}
+
+	entry := sampleReviewEntry()
+	if c.id != "" {
+		if entry, err = c.reviewUc.GetHistoryByID(cmd.Context(), c.id); err != nil {
+			return err
+		}
+	}
+
+	forwardedAt := time.Now()
+	if entry.SubmittedToCollabAt != nil {
+		forwardedAt = *entry.SubmittedToCollabAt
+	}
+
+	message, err := tmpl.Render(usecase.MessageTemplateData{ReviewHistoryEntry: entry, ForwardedAt: forwardedAt})
+	if err != nil {
+		return err
+	}
+
+	fmt.Println()
+	if tmpl.IsBuiltin() {
+		fmt.Printf("📄 Template: %s (built-in)\n", name)
+	} else {
+		fmt.Printf("📄 Template: %s (%s)\n", name, tmpl.Path)
+	}
+	if c.id == "" {
+		fmt.Println("   Data: sample request (use --id to render a real one)")
+	} else {
+		fmt.Printf("   Data: review %s\n", entry.ID)
+	}
+	fmt.Println("=========================")
+	fmt.Println()
+	fmt.Println(message)
+
+	return nil
+}
+
+func (c *TemplatePreviewCmd) loadTemplate(name string) (*usecase.MessageTemplate, error) {
+	if c.file == "" {
+		return usecase.LoadMessageTemplate(name)
+	}
+
+	text, err := os.ReadFile(c.file)
+	if err != nil {
+		return nil, fmt.Errorf("read template file: %w", err)
+	}
+	return usecase.ParseMessageTemplate(name, c.file, string(text))
+}
+
+// sampleReviewEntry returns a representative request for previewing templates
+func sampleReviewEntry() *usecase.ReviewHistoryEntry {
+	cfg := config.GetConfig()
+	now := time.Now()
+
+	return &entity.ReviewHistoryEntry{
+		ID:                 "0f3c9a7be21d4c5f8a6b1e2d3c4b5a69",
+		Title:              "Add retry with backoff to payment webhooks",
+		Description:        "Retries failed webhook deliveries with exponential backoff.\nDeployment notes: run the migration before rollout.",
+		Priority:           "P1",
+		Type:               usecase.RequestTypeFeature,
+		ReviewLinks:        []string{"https://github.com/acme/payments/pull/42", "https://gitlab.com/acme/infra/-/merge_requests/7"},
+		JiraLinks:          []string{"https://acme.atlassian.net/browse/PAY-123"},
+		Status:             entity.ReviewStatusTechLeadApproved,
+		SubmittedBy:        cfg.UserName,
+		SubmittedByEmail:   cfg.UserEmail,
+		SubmittedAt:        now.Add(-26 * time.Hour),
+		ApprovedByTechLead: true,
+		PullRequests: []entity.PullRequestInfo{{
+			Link:         "https://github.com/acme/payments/pull/42",
+			Repository:   "acme/payments",
+			Number:       42,
+			Title:        "Add retry with backoff to payment webhooks",
+			Author:       cfg.UserName,
+			State:        entity.PullRequestStateOpen,
+			Additions:    184,
+			Deletions:    27,
+			ChangedFiles: 6,
+			CIStatus:     entity.CIStatusSuccess,
+			FetchedAt:    now,
+		}},
+		JiraIssues: []entity.JiraIssueInfo{{
+			Link:      "https://acme.atlassian.net/browse/PAY-123",
+			Key:       "PAY-123",
+			Summary:   "Webhook deliveries are lost on timeouts",
+			Status:    "In Review",
+			Assignee:  cfg.UserName,
+			FetchedAt: now,
+		}},
+	}
+}
+
+func (c *TemplatePreviewCmd) initFlags() {
+	flags := c.cmd.Flags()
+	flags.StringVar(&c.id, "id", "", "Render against the review history entry with this ID")
+	flags.StringVarP(&c.file, "file", "f", "", "Render this template file instead of the active template")
+	flags.BoolVar(&c.source, "source", false, "Print the built-in template source instead of rendering")
+}
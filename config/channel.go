@@ -0,0 +1,103 @@
+package config
+
+import (
+	"fmt"
+	"slices"
+)
+
+// Notification channel types.
+const (
+	ChannelTypeGChat   = "gchat"
+	ChannelTypeSlack   = "slack"
+	ChannelTypeTeams   = "teams"
+	ChannelTypeWebhook = "webhook"
+)
+
+// ChannelTypes lists the supported notification channel types.
+var ChannelTypes = []string{ChannelTypeGChat, ChannelTypeSlack, ChannelTypeTeams, ChannelTypeWebhook}
+
+// Review stages that send notifications. The names double as the built-in
+// Google Chat channels backed by the gchat_*_webhook_url settings.
+const (
+	StageReview = "review" // new requests, amendments, withdrawals and reminders
+	StageCollab = "collab" // requests forwarded to head architect
+)
+
+// Channel is a named notification destination.
+type Channel struct {
+	Name       string `json:"name"`
+	Type       string `json:"type"`
+	WebhookURL string `json:"webhook_url"`
+
+	// Format applies to gchat channels: "cards" (default) or "text".
+	Format string `json:"format,omitempty"`
+
+	// Template applies to webhook channels: a Go text/template rendering the JSON body.
+	Template string `json:"template,omitempty"`
+	// Headers applies to webhook channels: extra HTTP headers sent with each request.
+	Headers map[string]string `json:"headers,omitempty"`
+}
+
+// PlainText reports whether rich layouts (cards, blocks) are disabled for the channel.
+func (ch Channel) PlainText() bool {
+	return ch.Format == MessageFormatText
+}
+
+// FindChannel returns a named channel. The built-in "review" and "collab" Google Chat
+// channels are available unless a configured channel uses the same name.
+func (c *Config) FindChannel(name string) (Channel, bool) {
+	for _, ch := range c.Channels {
+		if ch.Name == name {
+			return ch, true
+		}
+	}
+
+	builtin := map[string]string{
+		StageReview: c.GChatReviewWebhookURL,
+		StageCollab: c.GChatCollabWebhookURL,
+	}
+	if url, ok := builtin[name]; ok && url != "" {
+		return Channel{
+			Name:       name,
+			Type:       ChannelTypeGChat,
+			WebhookURL: url,
+			Format:     c.GChatMessageFormat,
+		}, true
+	}
+
+	return Channel{}, false
+}
+
+// StageChannels returns the channels a stage fans out to. Stages without an explicit
+// mapping use the built-in channel of the same name.
+func (c *Config) StageChannels(stage string) ([]Channel, error) {
+	names, ok := c.Stages[stage]
+	if !ok {
+		if ch, found := c.FindChannel(stage); found {
+			return []Channel{ch}, nil
+		}
+		return nil, fmt.Errorf("no notification channel configured for %s stage", stage)
+	}
+
+	return c.namedChannels(names, fmt.Sprintf("stage %q", stage), stage)
+}
+
+// namedChannels resolves the channel names used by a stage or route.
+func (c *Config) namedChannels(names []string, usedBy, stage string) ([]Channel, error) {
+	var channels []Channel
+	for _, name := range names {
+		ch, ok := c.FindChannel(name)
+		if !ok {
+			return nil, fmt.Errorf("channel %q used by %s is not configured", name, usedBy)
+		}
+		if !slices.Contains(ChannelTypes, ch.Type) {
+			return nil, fmt.Errorf("channel %q has unsupported type %q", name, ch.Type)
+		}
+		channels = append(channels, ch)
+	}
+
+	if len(channels) == 0 {
+		return nil, fmt.Errorf("no notification channel configured for %s stage", stage)
+	}
+	return channels, nil
+}
@@ -0,0 +1,67 @@
+package config
+
+import (
+	"os"
+	"strings"
+)
+
+// Code host APIs used to look up pull request details.
+const (
+	CodeHostGitHub = "github"
+	CodeHostGitLab = "gitlab"
+)
+
+// CodeHostAPI is where the API of a code host is reached and how to authenticate.
+type CodeHostAPI struct {
+	Kind    string // CodeHostGitHub or CodeHostGitLab
+	BaseURL string
+	Token   string // empty when none is configured
+}
+
+// CodeHostAPI returns the API serving pull request links of a host, or false when the
+// host has no supported API (e.g. Bitbucket). github.com and gitlab.com use their public
+// APIs; other hosts are treated as GitHub Enterprise or self-managed GitLab when their
+// name contains "github" or "gitlab". github_api_url and gitlab_api_url override the URL.
+func (c *Config) CodeHostAPI(host string) (CodeHostAPI, bool) {
+	host = strings.ToLower(host)
+	switch {
+	case host == "github.com":
+		return c.codeHostAPI(CodeHostGitHub, c.GitHubAPIURL, "https://api.github.com"), true
+	case strings.Contains(host, "gitlab"):
+		return c.codeHostAPI(CodeHostGitLab, c.GitLabAPIURL, "https://"+host+"/api/v4"), true
+	case strings.Contains(host, "github"):
+		return c.codeHostAPI(CodeHostGitHub, c.GitHubAPIURL, "https://"+host+"/api/v3"), true
+	default:
+		return CodeHostAPI{}, false
+	}
+}
+
+// CodeHostToken returns the configured API token of a code host, falling back to the
+// GITHUB_TOKEN or GITLAB_TOKEN environment variable.
+func (c *Config) CodeHostToken(kind string) string {
+	switch kind {
+	case CodeHostGitHub:
+		return firstNonEmpty(c.GitHubToken, os.Getenv("GITHUB_TOKEN"))
+	case CodeHostGitLab:
+		return firstNonEmpty(c.GitLabToken, os.Getenv("GITLAB_TOKEN"))
+	default:
+		return ""
+	}
+}
+
+func (c *Config) codeHostAPI(kind, configured, fallback string) CodeHostAPI {
+	return CodeHostAPI{
+		Kind:    kind,
+		BaseURL: strings.TrimRight(firstNonEmpty(configured, fallback), "/"),
+		Token:   c.CodeHostToken(kind),
+	}
+}
+
+func firstNonEmpty(values ...string) string {
+	for _, value := range values {
+		if value = strings.TrimSpace(value); value != "" {
+			return value
+		}
+	}
+	return ""
+}
@@ -7,16 +7,58 @@ import (
 	"path/filepath"
 )
 
+// Google Chat message formats.
+const (
+	MessageFormatCards = "cards"
+	MessageFormatText  = "text"
+)
+
 // Config holds environment and local user configuration.
 type Config struct {
 	GChatReviewWebhookURL string `json:"gchat_review_webhook_url,omitempty"`
 	GChatCollabWebhookURL string `json:"gchat_collab_webhook_url,omitempty"`
+	// GChatMessageFormat is "cards" (default, Cards v2) or "text" (plain text fallback).
+	GChatMessageFormat string `json:"gchat_message_format,omitempty"`
 
 	UserName  string `json:"user_name,omitempty"`
 	UserEmail string `json:"user_email,omitempty"`
 
 	PreferredEditor string `json:"preferred_editor,omitempty"`
 	ProjectRoot     string `json:"project_root,omitempty"`
+
+	// Channels are additional named notification destinations (Slack, Teams, webhooks, ...).
+	Channels []Channel `json:"channels,omitempty"`
+	// Stages maps a review stage ("review", "collab") to the channel names it notifies.
+	Stages map[string][]string `json:"stages,omitempty"`
+	// Routes pick other channels for requests by repository, Jira project, priority or project dir.
+	Routes []Route `json:"routes,omitempty"`
+	// Team is the roster of developers, tech leads and architects mentioned in chat messages.
+	Team []TeamMember `json:"team,omitempty"`
+
+	// ReviewSLA maps a priority to how long a request may wait for review, e.g. "2h" or "2bd".
+	ReviewSLA map[string]string `json:"review_sla,omitempty"`
+	// ReminderCooldown is the minimum time between reminders for the same request (e.g. "24h").
+	// Empty uses DefaultReminderCooldown, "0" disables it.
+	ReminderCooldown string `json:"reminder_cooldown,omitempty"`
+
+	// JiraBaseURL expands bare issue keys like "ABC-123", e.g. "https://org.atlassian.net".
+	JiraBaseURL string `json:"jira_base_url,omitempty"`
+	// PRHosts are self-hosted code hosts accepted in pull request links, e.g. "git.example.com".
+	PRHosts []string `json:"pr_hosts,omitempty"`
+
+	// GitHubToken and GitLabToken enable pull request details from the code host API
+	// (see CodeHostToken). The API URLs are only needed for self-hosted instances.
+	GitHubToken  string `json:"github_token,omitempty"`
+	GitHubAPIURL string `json:"github_api_url,omitempty"`
+	GitLabToken  string `json:"gitlab_token,omitempty"`
+	GitLabAPIURL string `json:"gitlab_api_url,omitempty"`
+
+	// JiraUser and JiraToken enable issue details from the Jira REST API at JiraBaseURL
+	// (see JiraCredentials).
+	JiraUser  string `json:"jira_user,omitempty"`
+	JiraToken string `json:"jira_token,omitempty"`
+	// JiraActions maps a review stage ("review", "collab") to what happens to the linked issues.
+	JiraActions map[string]JiraAction `json:"jira_actions,omitempty"`
 }
 
 var cached *Config
@@ -34,32 +76,62 @@ func GetConfig() *Config {
 		cfg.UserEmail = local.UserEmail
 		cfg.GChatReviewWebhookURL = local.GChatReviewWebhookURL
 		cfg.GChatCollabWebhookURL = local.GChatCollabWebhookURL
+		cfg.GChatMessageFormat = local.GChatMessageFormat
 		cfg.PreferredEditor = local.PreferredEditor
 		cfg.ProjectRoot = local.ProjectRoot
+		cfg.Channels = local.Channels
+		cfg.Stages = local.Stages
+		cfg.Routes = local.Routes
+		cfg.Team = local.Team
+		cfg.ReviewSLA = local.ReviewSLA
+		cfg.ReminderCooldown = local.ReminderCooldown
+		cfg.JiraBaseURL = local.JiraBaseURL
+		cfg.PRHosts = local.PRHosts
+		cfg.GitHubToken = local.GitHubToken
+		cfg.GitHubAPIURL = local.GitHubAPIURL
+		cfg.GitLabToken = local.GitLabToken
+		cfg.GitLabAPIURL = local.GitLabAPIURL
+		cfg.JiraUser = local.JiraUser
+		cfg.JiraToken = local.JiraToken
+		cfg.JiraActions = local.JiraActions
 	}
 
 	cached = cfg
 	return cached
 }
 
-// SaveLocalConfig stores configuration in ~/.cool-cli/config.json.
+// SaveLocalConfig stores configuration in ~/.cool-cli/config.json. The file holds API
+// tokens, so it and its directory are only accessible by the user; files created by
+// earlier versions are tightened on save.
 func SaveLocalConfig(c *Config) error {
 	path := getLocalConfigPath()
-	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+	dir := filepath.Dir(path)
+	if err := os.MkdirAll(dir, 0o700); err != nil {
 		return fmt.Errorf("create config dir: %w", err)
 	}
+	if err := os.Chmod(dir, 0o700); err != nil {
+		return fmt.Errorf("secure config dir: %w", err)
+	}
 
 	data, err := json.MarshalIndent(c, "", "  ")
 	if err != nil {
 		return fmt.Errorf("encode config: %w", err)
 	}
 
-	if err = os.WriteFile(path, data, 0o644); err != nil {
+	if err = os.WriteFile(path, data, 0o600); err != nil {
 		return fmt.Errorf("write config: %w", err)
 	}
+	if err := os.Chmod(path, 0o600); err != nil {
+		return fmt.Errorf("secure config: %w", err)
+	}
 	return nil
 }
 
+// UsePlainTextMessages reports whether chat messages should be sent as plain text only.
+func (c *Config) UsePlainTextMessages() bool {
+	return c.GChatMessageFormat == MessageFormatText
+}
+
 func loadLocalConfig() (*Config, error) {
 	path := getLocalConfigPath()
 	data, err := os.ReadFile(path)
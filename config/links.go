@@ -0,0 +1,52 @@
+package config
+
+import (
+	"os"
+	"strings"
+)
+
+// DefaultPRHosts are the code hosts whose pull request links are always accepted.
+var DefaultPRHosts = []string{"github.com", "gitlab.com", "bitbucket.org"}
+
+// KnownPRHosts returns the default code hosts followed by the configured self-hosted ones.
+func (c *Config) KnownPRHosts() []string {
+	hosts := append([]string(nil), DefaultPRHosts...)
+	for _, host := range c.PRHosts {
+		if host = strings.ToLower(strings.TrimSpace(host)); host != "" {
+			hosts = append(hosts, host)
+		}
+	}
+	return hosts
+}
+
+// JiraBrowseURL returns the link of a Jira issue key on the configured Jira, or false
+// when jira_base_url is not set.
+func (c *Config) JiraBrowseURL(key string) (string, bool) {
+	base := strings.TrimRight(strings.TrimSpace(c.JiraBaseURL), "/")
+	if base == "" {
+		return "", false
+	}
+	return base + "/browse/" + key, true
+}
+
+// JiraAction is what happens to the Jira issues of a request when it reaches a stage.
+type JiraAction struct {
+	// Transition is the workflow transition (or its target status) to apply, e.g. "In Review".
+	Transition string `json:"transition,omitempty"`
+	// Comment adds a comment linking the pull requests.
+	Comment bool `json:"comment,omitempty"`
+}
+
+// JiraCredentials returns the Jira API user and token, falling back to the JIRA_USER and
+// JIRA_API_TOKEN environment variables. A token without a user is sent as a bearer token
+// (Jira Server/Data Center personal access tokens); with a user it is an API token for
+// basic authentication (Jira Cloud).
+func (c *Config) JiraCredentials() (user, token string) {
+	return firstNonEmpty(c.JiraUser, os.Getenv("JIRA_USER")), firstNonEmpty(c.JiraToken, os.Getenv("JIRA_API_TOKEN"))
+}
+
+// JiraAPIConfigured reports whether the Jira REST API can be used.
+func (c *Config) JiraAPIConfigured() bool {
+	_, token := c.JiraCredentials()
+	return strings.TrimSpace(c.JiraBaseURL) != "" && token != ""
+}
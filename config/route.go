@@ -0,0 +1,146 @@
+package config
+
+import (
+	"fmt"
+	"path"
+	"path/filepath"
+	"strings"
+)
+
+// Route sends the review requests it matches to their own channels instead of the
+// channels of the stages. All conditions that are set must match; within a condition
+// one of the values is enough. A route without conditions matches every request.
+type Route struct {
+	Name string `json:"name"`
+
+	// Repositories are "owner/name" paths of pull request links, "*" globs allowed (e.g. "payments/*").
+	Repositories []string `json:"repositories,omitempty"`
+	// JiraProjects are Jira project keys, e.g. "PAY".
+	JiraProjects []string `json:"jira_projects,omitempty"`
+	// Priorities are request priorities, e.g. "P0".
+	Priorities []string `json:"priorities,omitempty"`
+	// ProjectDirs are directories under project_root the request was created in, "*" globs allowed.
+	ProjectDirs []string `json:"project_dirs,omitempty"`
+
+	// Squad is the team squad whose tech leads and architects are mentioned for matching
+	// requests, instead of the squad of the requester.
+	Squad string `json:"squad,omitempty"`
+
+	// Stages maps a review stage ("review", "collab") to the channel names it notifies.
+	// Stages not listed keep their default channels.
+	Stages map[string][]string `json:"stages"`
+}
+
+// RouteTarget is what routes are matched against.
+type RouteTarget struct {
+	Repositories []string
+	JiraProjects []string
+	Priority     string
+	ProjectDir   string
+}
+
+// RouteMatch is the route selected for a request and why it was selected.
+type RouteMatch struct {
+	Route  Route
+	Reason string
+}
+
+// MatchRoute returns the first route matching the target, nil when none does and the
+// stage channels are used.
+func (c *Config) MatchRoute(t RouteTarget) *RouteMatch {
+	for _, route := range c.Routes {
+		if reason, ok := route.match(t); ok {
+			return &RouteMatch{Route: route, Reason: reason}
+		}
+	}
+	return nil
+}
+
+// RouteChannels returns the channels a stage fans out to for a matched route, falling
+// back to StageChannels when there is no route or it does not list the stage.
+func (c *Config) RouteChannels(match *RouteMatch, stage string) ([]Channel, error) {
+	if match == nil {
+		return c.StageChannels(stage)
+	}
+	names, ok := match.Route.Stages[stage]
+	if !ok {
+		return c.StageChannels(stage)
+	}
+	return c.namedChannels(names, fmt.Sprintf("route %q", match.Route.Name), stage)
+}
+
+// ProjectDir returns the top-level directory under ProjectRoot that dir is in, empty when
+// no project root is set or dir is outside of it.
+func (c *Config) ProjectDir(dir string) string {
+	if c.ProjectRoot == "" {
+		return ""
+	}
+
+	rel, err := filepath.Rel(filepath.Clean(c.ProjectRoot), filepath.Clean(dir))
+	if err != nil || rel == "." || rel == ".." || strings.HasPrefix(rel, ".."+string(filepath.Separator)) {
+		return ""
+	}
+	return strings.Split(filepath.ToSlash(rel), "/")[0]
+}
+
+// match reports whether every condition of the route holds for the target, together with
+// a description of the conditions that matched.
+func (r Route) match(t RouteTarget) (string, bool) {
+	var reasons []string
+
+	if len(r.Repositories) > 0 {
+		repo, pattern, ok := matchAny(r.Repositories, t.Repositories)
+		if !ok {
+			return "", false
+		}
+		reasons = append(reasons, describeMatch("repository", repo, pattern))
+	}
+	if len(r.JiraProjects) > 0 {
+		project, pattern, ok := matchAny(r.JiraProjects, t.JiraProjects)
+		if !ok {
+			return "", false
+		}
+		reasons = append(reasons, describeMatch("Jira project", project, pattern))
+	}
+	if len(r.Priorities) > 0 {
+		priority, pattern, ok := matchAny(r.Priorities, []string{t.Priority})
+		if !ok {
+			return "", false
+		}
+		reasons = append(reasons, describeMatch("priority", priority, pattern))
+	}
+	if len(r.ProjectDirs) > 0 {
+		dir, pattern, ok := matchAny(r.ProjectDirs, []string{t.ProjectDir})
+		if !ok {
+			return "", false
+		}
+		reasons = append(reasons, describeMatch("project dir", dir, pattern))
+	}
+
+	if len(reasons) == 0 {
+		return "matches every request", true
+	}
+	return strings.Join(reasons, ", "), true
+}
+
+// matchAny returns the first value matching one of the patterns, case-insensitively.
+func matchAny(patterns, values []string) (value, pattern string, ok bool) {
+	for _, value := range values {
+		if value == "" {
+			continue
+		}
+		for _, pattern := range patterns {
+			if matched, _ := path.Match(strings.ToLower(pattern), strings.ToLower(value)); matched {
+				return value, pattern, true
+			}
+		}
+	}
+	return "", "", false
+}
+
+func describeMatch(condition, value, pattern string) string {
+	if strings.EqualFold(value, pattern) {
+		return fmt.Sprintf("%s %s", condition, value)
+	}
+	return fmt.Sprintf("%s %s matches %q", condition, value, pattern)
+}
@@ -0,0 +1,107 @@
+package config
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// DefaultReviewSLA is used for priorities without a review_sla entry.
+var DefaultReviewSLA = map[string]string{
+	"P0": "2h",
+	"P1": "1bd",
+	"P2": "2bd",
+	"P3": "5bd",
+}
+
+// DefaultReminderCooldown is the minimum time between two reminders for the same request.
+const DefaultReminderCooldown = 24 * time.Hour
+
+// SLA is the maximum time a review request may wait at a priority.
+type SLA struct {
+	Duration time.Duration
+	// BusinessDays counts only Monday to Friday when measuring the wait.
+	BusinessDays bool
+}
+
+// String returns the SLA in config notation, e.g. "2h" or "2bd".
+func (s SLA) String() string {
+	day := 24 * time.Hour
+	switch {
+	case s.BusinessDays && s.Duration%day == 0:
+		return fmt.Sprintf("%dbd", s.Duration/day)
+	case s.BusinessDays:
+		return fmt.Sprintf("%.1fbd", s.Duration.Hours()/24)
+	case s.Duration%day == 0:
+		return fmt.Sprintf("%dd", s.Duration/day)
+	default:
+		text := s.Duration.String()
+		if strings.HasSuffix(text, "m0s") {
+			text = strings.TrimSuffix(text, "0s")
+		}
+		if strings.HasSuffix(text, "h0m") {
+			text = strings.TrimSuffix(text, "0m")
+		}
+		return text
+	}
+}
+
+// ParseSLA parses a Go duration ("90m", "2h"), calendar days ("3d") or business days ("2bd").
+func ParseSLA(value string) (SLA, error) {
+	value = strings.TrimSpace(strings.ToLower(value))
+
+	for _, unit := range []struct {
+		suffix   string
+		business bool
+	}{{"bd", true}, {"d", false}} {
+		if number, ok := strings.CutSuffix(value, unit.suffix); ok {
+			days, err := strconv.ParseFloat(number, 64)
+			if err != nil || days <= 0 {
+				return SLA{}, fmt.Errorf("invalid SLA %q", value)
+			}
+			return SLA{Duration: time.Duration(days * float64(24*time.Hour)), BusinessDays: unit.business}, nil
+		}
+	}
+
+	d, err := time.ParseDuration(value)
+	if err != nil || d <= 0 {
+		return SLA{}, fmt.Errorf("invalid SLA %q", value)
+	}
+	return SLA{Duration: d}, nil
+}
+
+// ReviewSLAFor returns the SLA configured for a priority, falling back to DefaultReviewSLA.
+// ok is false when the priority has no SLA at all.
+func (c *Config) ReviewSLAFor(priority string) (sla SLA, ok bool, err error) {
+	value, found := c.ReviewSLA[priority]
+	if !found {
+		value, found = DefaultReviewSLA[priority]
+	}
+	if !found {
+		return SLA{}, false, nil
+	}
+
+	sla, err = ParseSLA(value)
+	if err != nil {
+		return SLA{}, false, fmt.Errorf("review_sla %s: %w", priority, err)
+	}
+	return sla, true, nil
+}
+
+// ReminderCooldownDuration returns the configured reminder cooldown or the default when
+// none is set. An explicit "0" disables the cooldown.
+func (c *Config) ReminderCooldownDuration() (time.Duration, error) {
+	if c.ReminderCooldown == "" {
+		return DefaultReminderCooldown, nil
+	}
+
+	d, err := time.ParseDuration(c.ReminderCooldown)
+	if err != nil {
+		return 0, fmt.Errorf("reminder_cooldown: %w", err)
+	}
+	if d < 0 {
+		return 0, fmt.Errorf("reminder_cooldown: must not be negative, got %q", c.ReminderCooldown)
+	}
+	return d, nil
+}
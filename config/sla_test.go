@@ -0,0 +1,31 @@
+package config
+
+import (
+	"testing"
+	"time"
+)
+
+func TestReminderCooldownDuration(t *testing.T) {
+	tests := []struct {
+		value   string
+		want    time.Duration
+		wantErr bool
+	}{
+		{value: "", want: DefaultReminderCooldown},
+		{value: "4h", want: 4 * time.Hour},
+		{value: "0", want: 0},
+		{value: "-1h", wantErr: true},
+		{value: "soon", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		got, err := (&Config{ReminderCooldown: tt.value}).ReminderCooldownDuration()
+		if (err != nil) != tt.wantErr {
+			t.Errorf("ReminderCooldownDuration(%q) error = %v, wantErr %v", tt.value, err, tt.wantErr)
+			continue
+		}
+		if got != tt.want {
+			t.Errorf("ReminderCooldownDuration(%q) = %s, want %s", tt.value, got, tt.want)
+		}
+	}
+}
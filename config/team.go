@@ -0,0 +1,108 @@
+package config
+
+import (
+	"fmt"
+	"net/mail"
+	"slices"
+	"strings"
+)
+
+// Team roles.
+const (
+	RoleDeveloper = "developer"
+	RoleTechLead  = "tech-lead"
+	RoleArchitect = "architect"
+)
+
+// TeamRoles lists the supported team roles.
+var TeamRoles = []string{RoleDeveloper, RoleTechLead, RoleArchitect}
+
+// TeamMember is a person in the team roster. Tech leads and architects are mentioned in
+// the chat messages of the requests they review.
+type TeamMember struct {
+	Name  string `json:"name"`
+	Email string `json:"email"`
+	// GChatUserID is the numeric Google Chat user ID, mentioned as "<users/ID>".
+	GChatUserID string `json:"gchat_user_id,omitempty"`
+	Role        string `json:"role"`
+	Squad       string `json:"squad,omitempty"`
+}
+
+// Normalize trims the member fields, lower-cases the email and role, strips a "users/"
+// prefix from the user ID and checks that the member is complete.
+func (m *TeamMember) Normalize() error {
+	m.Name = strings.TrimSpace(m.Name)
+	m.Email = strings.ToLower(strings.TrimSpace(m.Email))
+	m.GChatUserID = strings.TrimPrefix(strings.TrimSpace(m.GChatUserID), "users/")
+	m.Role = strings.ToLower(strings.TrimSpace(m.Role))
+	m.Squad = strings.TrimSpace(m.Squad)
+
+	if m.Name == "" {
+		return fmt.Errorf("name is required")
+	}
+	if _, err := mail.ParseAddress(m.Email); err != nil {
+		return fmt.Errorf("invalid email %q", m.Email)
+	}
+	if !slices.Contains(TeamRoles, m.Role) {
+		return fmt.Errorf("invalid role %q (must be one of: %s)", m.Role, strings.Join(TeamRoles, ", "))
+	}
+	if strings.ContainsAny(m.GChatUserID, "/<> ") {
+		return fmt.Errorf("invalid Google Chat user ID %q", m.GChatUserID)
+	}
+	return nil
+}
+
+// FindTeamMember returns the roster member with the given email.
+func (c *Config) FindTeamMember(email string) (TeamMember, bool) {
+	for _, m := range c.Team {
+		if strings.EqualFold(m.Email, strings.TrimSpace(email)) {
+			return m, true
+		}
+	}
+	return TeamMember{}, false
+}
+
+// SetTeamMember adds a member to the roster, or replaces the member with the same email.
+// It reports whether a member was replaced.
+func (c *Config) SetTeamMember(member TeamMember) bool {
+	for i, m := range c.Team {
+		if strings.EqualFold(m.Email, member.Email) {
+			c.Team[i] = member
+			return true
+		}
+	}
+	c.Team = append(c.Team, member)
+	return false
+}
+
+// RemoveTeamMember removes the member with the given email and returns it, reporting
+// whether it was found.
+func (c *Config) RemoveTeamMember(email string) (TeamMember, bool) {
+	for i, m := range c.Team {
+		if strings.EqualFold(m.Email, strings.TrimSpace(email)) {
+			c.Team = slices.Delete(c.Team, i, i+1)
+			return m, true
+		}
+	}
+	return TeamMember{}, false
+}
+
+// TeamReviewers returns the members with a role in a squad, or the members with that role
+// and no squad when the squad has none. The member with the exclude email is left out, so
+// nobody is asked to review their own request.
+func (c *Config) TeamReviewers(role, squad, exclude string) []TeamMember {
+	find := func(squad string) []TeamMember {
+		var members []TeamMember
+		for _, m := range c.Team {
+			if m.Role == role && strings.EqualFold(m.Squad, squad) && !strings.EqualFold(m.Email, exclude) {
+				members = append(members, m)
+			}
+		}
+		return members
+	}
+
+	if members := find(squad); len(members) > 0 || squad == "" {
+		return members
+	}
+	return find("")
+}
@@ -0,0 +1,48 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+)
+
+// templatesDirName is the directory holding message templates inside a .cool-cli directory.
+const templatesDirName = "templates"
+
+// UserTemplatesDir returns ~/.cool-cli/templates.
+func UserTemplatesDir() string {
+	return filepath.Join(filepath.Dir(getLocalConfigPath()), templatesDirName)
+}
+
+// ProjectTemplatesDir returns the nearest .cool-cli/templates directory in the working
+// directory or one of its parents, or "" if the current project has none.
+func ProjectTemplatesDir() string {
+	dir, err := os.Getwd()
+	if err != nil {
+		return ""
+	}
+
+	userDir := UserTemplatesDir()
+	for {
+		candidate := filepath.Join(dir, ".cool-cli", templatesDirName)
+		if candidate == userDir {
+			return ""
+		}
+		if info, err := os.Stat(candidate); err == nil && info.IsDir() {
+			return candidate
+		}
+
+		parent := filepath.Dir(dir)
+		if parent == dir {
+			return ""
+		}
+		dir = parent
+	}
+}
+
+// TemplateDirs returns the message template directories by precedence, project first.
+func TemplateDirs() []string {
+	if project := ProjectTemplatesDir(); project != "" {
+		return []string{project, UserTemplatesDir()}
+	}
+	return []string{UserTemplatesDir()}
+}
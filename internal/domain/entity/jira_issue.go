@@ -0,0 +1,25 @@
+package entity
+
+import (
+	"strings"
+	"time"
+)
+
+// JiraIssueInfo is Jira issue metadata fetched from the Jira REST API
+type JiraIssueInfo struct {
+	Link      string    `json:"link"`
+	Key       string    `json:"key"`
+	Summary   string    `json:"summary"`
+	Status    string    `json:"status"`
+	Assignee  string    `json:"assignee,omitempty"` // display name, empty when unassigned
+	FetchedAt time.Time `json:"fetched_at"`
+}
+
+// Details returns a compact one-line description, e.g. "Fix login · In Progress · Bob"
+func (i *JiraIssueInfo) Details() string {
+	assignee := i.Assignee
+	if assignee == "" {
+		assignee = "unassigned"
+	}
+	return strings.Join([]string{i.Summary, i.Status, assignee}, " · ")
+}
@@ -0,0 +1,63 @@
+package entity
+
+import "time"
+
+// OutboxStatus represents the delivery state of an outbound notification
+type OutboxStatus string
+
+const (
+	OutboxStatusPending OutboxStatus = "pending" // waiting for its first or next attempt
+	OutboxStatusSent    OutboxStatus = "sent"
+	OutboxStatusFailed  OutboxStatus = "failed" // gave up; only retried by an explicit flush --all
+)
+
+// NotificationField is a labelled detail shown in rich message layouts
+type NotificationField struct {
+	Label string `json:"label"`
+	Value string `json:"value"`
+}
+
+// Mention is a team member called out in a notification
+type Mention struct {
+	Name        string `json:"name"`
+	GChatUserID string `json:"gchat_user_id,omitempty"`
+}
+
+// OutboxMessage is a notification queued for delivery to a single channel
+type OutboxMessage struct {
+	ID      string `json:"id"`
+	EntryID string `json:"entry_id"`
+	Channel string `json:"channel"` // channel name, resolved from config on every attempt
+
+	// Notification content
+	Kind     string              `json:"kind"`
+	Headline string              `json:"headline"`
+	Text     string              `json:"text"`
+	Fields   []NotificationField `json:"fields,omitempty"`
+	Mentions []Mention           `json:"mentions,omitempty"`
+	Rich     bool                `json:"rich,omitempty"`
+
+	// EventIndex is the timeline event the delivered message belongs to (-1 for none)
+	EventIndex int `json:"event_index"`
+
+	Status        OutboxStatus `json:"status"`
+	Attempts      int          `json:"attempts"`
+	LastError     string       `json:"last_error,omitempty"`
+	CreatedAt     time.Time    `json:"created_at"`
+	LastAttemptAt *time.Time   `json:"last_attempt_at,omitempty"`
+	NextAttemptAt *time.Time   `json:"next_attempt_at,omitempty"`
+	SentAt        *time.Time   `json:"sent_at,omitempty"`
+}
+
+// IsDelivered reports whether the message reached its channel
+func (m *OutboxMessage) IsDelivered() bool {
+	return m.Status == OutboxStatusSent
+}
+
+// IsDue reports whether a pending message may be attempted at the given time
+func (m *OutboxMessage) IsDue(now time.Time) bool {
+	if m.Status != OutboxStatusPending {
+		return false
+	}
+	return m.NextAttemptAt == nil || !now.Before(*m.NextAttemptAt)
+}
@@ -0,0 +1,93 @@
+package entity
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// PullRequestState is the state of a pull or merge request on its code host
+type PullRequestState string
+
+const (
+	PullRequestStateOpen   PullRequestState = "open"
+	PullRequestStateClosed PullRequestState = "closed" // closed without merging
+	PullRequestStateMerged PullRequestState = "merged"
+)
+
+// CIStatus is the combined result of the checks on the head commit of a pull request
+type CIStatus string
+
+const (
+	CIStatusNone    CIStatus = "" // no checks reported
+	CIStatusPending CIStatus = "pending"
+	CIStatusSuccess CIStatus = "success"
+	CIStatusFailure CIStatus = "failure"
+)
+
+// PullRequestInfo is pull request metadata fetched from the code host API
+type PullRequestInfo struct {
+	Link         string           `json:"link"`
+	Repository   string           `json:"repository"`
+	Number       int              `json:"number"`
+	Title        string           `json:"title"`
+	Author       string           `json:"author"`
+	State        PullRequestState `json:"state"`
+	Draft        bool             `json:"draft,omitempty"`
+	Additions    int              `json:"additions"`
+	Deletions    int              `json:"deletions"`
+	ChangedFiles int              `json:"changed_files"`
+	CIStatus     CIStatus         `json:"ci_status,omitempty"`
+	FetchedAt    time.Time        `json:"fetched_at"`
+}
+
+// StateLabel returns the state with its badge, e.g. "🟢 open" or "📝 draft"
+func (p *PullRequestInfo) StateLabel() string {
+	switch {
+	case p.State == PullRequestStateMerged:
+		return "🟣 merged"
+	case p.State == PullRequestStateClosed:
+		return "🔴 closed"
+	case p.Draft:
+		return "📝 draft"
+	default:
+		return "🟢 open"
+	}
+}
+
+// CILabel returns the CI status with its badge, empty when no checks were reported
+func (p *PullRequestInfo) CILabel() string {
+	switch p.CIStatus {
+	case CIStatusSuccess:
+		return "✅ CI passed"
+	case CIStatusFailure:
+		return "❌ CI failed"
+	case CIStatusPending:
+		return "⏳ CI running"
+	default:
+		return ""
+	}
+}
+
+// Summary returns a compact one-line description, e.g.
+// "🟢 open · +120/-30 in 5 files · ✅ CI passed · by alice"
+func (p *PullRequestInfo) Summary() string {
+	parts := []string{
+		p.StateLabel(),
+		fmt.Sprintf("+%d/-%d in %d file%s", p.Additions, p.Deletions, p.ChangedFiles, plural(p.ChangedFiles)),
+	}
+	if ci := p.CILabel(); ci != "" {
+		parts = append(parts, ci)
+	}
+	if p.Author != "" {
+		parts = append(parts, "by "+p.Author)
+	}
+	return strings.Join(parts, " · ")
+}
+
+func plural(n int) string {
+	if n == 1 {
+		return ""
+	}
+	return "s"
+}
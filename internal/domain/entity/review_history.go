@@ -1,22 +1,217 @@
 package entity
 
-import "time"
+import (
+	"slices"
+	"time"
+)
+
+// ReviewStatus represents a stage in the review lifecycle
+type ReviewStatus string
+
+const (
+	ReviewStatusDraft             ReviewStatus = "draft"
+	ReviewStatusSubmitted         ReviewStatus = "submitted"
+	ReviewStatusChangesRequested  ReviewStatus = "changes-requested"
+	ReviewStatusTechLeadApproved  ReviewStatus = "tech-lead-approved"
+	ReviewStatusForwarded         ReviewStatus = "forwarded"
+	ReviewStatusArchitectApproved ReviewStatus = "architect-approved"
+	ReviewStatusMerged            ReviewStatus = "merged"
+	ReviewStatusClosed            ReviewStatus = "closed" // pull requests closed without merging
+	ReviewStatusWithdrawn         ReviewStatus = "withdrawn"
+)
+
+// ReviewStatuses lists every review lifecycle status
+var ReviewStatuses = []ReviewStatus{
+	ReviewStatusDraft,
+	ReviewStatusSubmitted,
+	ReviewStatusChangesRequested,
+	ReviewStatusTechLeadApproved,
+	ReviewStatusForwarded,
+	ReviewStatusArchitectApproved,
+	ReviewStatusMerged,
+	ReviewStatusClosed,
+	ReviewStatusWithdrawn,
+}
+
+// IsValid reports whether the status is one of ReviewStatuses
+func (s ReviewStatus) IsValid() bool {
+	return slices.Contains(ReviewStatuses, s)
+}
+
+// IsTerminal reports whether the review lifecycle has ended
+func (s ReviewStatus) IsTerminal() bool {
+	return s == ReviewStatusMerged || s == ReviewStatusClosed || s == ReviewStatusWithdrawn
+}
+
+// ReviewEventType identifies what happened to a review request
+type ReviewEventType string
+
+const (
+	ReviewEventDrafted   ReviewEventType = "drafted"
+	ReviewEventSubmitted ReviewEventType = "submitted"
+	ReviewEventApproved  ReviewEventType = "approved"
+	ReviewEventRejected  ReviewEventType = "rejected"
+	ReviewEventForwarded ReviewEventType = "forwarded"
+	ReviewEventWithdrawn ReviewEventType = "withdrawn"
+	ReviewEventAmended   ReviewEventType = "amended"
+	ReviewEventMerged    ReviewEventType = "merged"   // pull requests merged, found by review sync
+	ReviewEventClosed    ReviewEventType = "closed"   // pull requests closed, found by review sync
+	ReviewEventReminded  ReviewEventType = "reminded" // SLA reminder, does not change the status
+	ReviewEventJira      ReviewEventType = "jira"     // Jira issues transitioned/commented, does not change the status
+)
+
+// ChangesStage reports whether the event moves the request to a new stage, as opposed to
+// side events like reminders and Jira updates recorded within a stage
+func (t ReviewEventType) ChangesStage() bool {
+	return t != ReviewEventReminded && t != ReviewEventJira
+}
+
+// ReviewEvent is a single append-only timeline record of a review request
+type ReviewEvent struct {
+	Type    ReviewEventType `json:"type"`
+	Actor   string          `json:"actor,omitempty"`
+	At      time.Time       `json:"at"`
+	Status  ReviewStatus    `json:"status,omitempty"` // status after the event
+	Note    string          `json:"note,omitempty"`
+	Message string          `json:"message,omitempty"` // outbound chat message, if one was sent
+
+	MessageName string `json:"message_name,omitempty"` // chat message created for Message
+}
+
+// ChatThread records the chat thread holding all messages about a request in one channel
+type ChatThread struct {
+	Key         string `json:"key"`
+	Name        string `json:"name,omitempty"`
+	MessageName string `json:"message_name,omitempty"` // first message of the thread
+}
+
+// ReviewRevision is a snapshot of the editable fields replaced by an amendment
+type ReviewRevision struct {
+	Revision    int       `json:"revision"`
+	Title       string    `json:"title"`
+	Description string    `json:"description"`
+	Priority    string    `json:"priority"`
+	Type        string    `json:"type,omitempty"`
+	ReviewLinks []string  `json:"review_links"`
+	JiraLinks   []string  `json:"jira_links"`
+	AmendedAt   time.Time `json:"amended_at"`
+	AmendedBy   string    `json:"amended_by,omitempty"`
+}
 
 // ReviewHistoryEntry represents a single review request history entry
 type ReviewHistoryEntry struct {
-	ID                  string     `json:"id"`
-	Title               string     `json:"title"`
-	Description         string     `json:"description"`
-	Priority            string     `json:"priority"`
-	ReviewLinks         []string   `json:"review_links"`
-	JiraLinks           []string   `json:"jira_links"`
-	SubmittedBy         string     `json:"submitted_by"`
-	SubmittedByEmail    string     `json:"submitted_by_email"`
-	SubmittedAt         time.Time  `json:"submitted_at"`
-	SubmittedToCollab   bool       `json:"submitted_to_collab"`
-	SubmittedToCollabAt *time.Time `json:"submitted_to_collab_at,omitempty"`
-	SubmittedToCollabBy string     `json:"submitted_to_collab_by,omitempty"`
-	ApprovedByTechLead  bool       `json:"approved_by_tech_lead"`
-	ApprovedByArchitect bool       `json:"approved_by_architect"`
-	Notes               string     `json:"notes,omitempty"`
+	ID                  string           `json:"id"`
+	Title               string           `json:"title"`
+	Description         string           `json:"description"`
+	Priority            string           `json:"priority"`
+	Type                string           `json:"type,omitempty"` // request type, e.g. "feature" or "hotfix"
+	ReviewLinks         []string         `json:"review_links"`
+	JiraLinks           []string         `json:"jira_links"`
+	ProjectDir          string           `json:"project_dir,omitempty"` // directory under project_root the request was created in
+	Status              ReviewStatus     `json:"status,omitempty"`
+	SubmittedBy         string           `json:"submitted_by"`
+	SubmittedByEmail    string           `json:"submitted_by_email"`
+	SubmittedAt         time.Time        `json:"submitted_at"`
+	UpdatedAt           *time.Time       `json:"updated_at,omitempty"`
+	SubmittedToCollab   bool             `json:"submitted_to_collab"`
+	SubmittedToCollabAt *time.Time       `json:"submitted_to_collab_at,omitempty"`
+	SubmittedToCollabBy string           `json:"submitted_to_collab_by,omitempty"`
+	ApprovedByTechLead  bool             `json:"approved_by_tech_lead"`
+	ApprovedByArchitect bool             `json:"approved_by_architect"`
+	Notes               string           `json:"notes,omitempty"`
+	Events              []ReviewEvent    `json:"events,omitempty"`
+	Revisions           []ReviewRevision `json:"revisions,omitempty"`
+
+	// Threads maps a chat channel (e.g. "review", "collab") to the thread used for this request
+	Threads map[string]*ChatThread `json:"threads,omitempty"`
+
+	// PullRequests holds the code host details of ReviewLinks as of the last message sent
+	PullRequests []PullRequestInfo `json:"pull_requests,omitempty"`
+	// JiraIssues holds the Jira details of JiraLinks as of the last message sent
+	JiraIssues []JiraIssueInfo `json:"jira_issues,omitempty"`
+}
+
+// PullRequest returns the fetched details of a review link, or nil when none are known
+func (e *ReviewHistoryEntry) PullRequest(link string) *PullRequestInfo {
+	for i := range e.PullRequests {
+		if e.PullRequests[i].Link == link {
+			return &e.PullRequests[i]
+		}
+	}
+	return nil
+}
+
+// JiraIssue returns the fetched details of a Jira link, or nil when none are known
+func (e *ReviewHistoryEntry) JiraIssue(link string) *JiraIssueInfo {
+	for i := range e.JiraIssues {
+		if e.JiraIssues[i].Link == link {
+			return &e.JiraIssues[i]
+		}
+	}
+	return nil
+}
+
+// CurrentRevision returns the revision number of the current content (1 for never amended)
+func (e *ReviewHistoryEntry) CurrentRevision() int {
+	return len(e.Revisions) + 1
+}
+
+// AppendEvent records a new timeline event
+func (e *ReviewHistoryEntry) AppendEvent(event ReviewEvent) {
+	e.Events = append(e.Events, event)
+}
+
+// Timeline returns the recorded events. For entries saved before events were recorded,
+// the submission (and forward) is reconstructed and put in front of any events appended
+// since, e.g. by an approval or a reminder.
+func (e *ReviewHistoryEntry) Timeline() []ReviewEvent {
+	if len(e.Events) > 0 {
+		if first := e.Events[0].Type; first == ReviewEventSubmitted || first == ReviewEventDrafted {
+			return e.Events
+		}
+	}
+
+	events := []ReviewEvent{{
+		Type:   ReviewEventSubmitted,
+		Actor:  e.SubmittedBy,
+		At:     e.SubmittedAt,
+		Status: ReviewStatusSubmitted,
+	}}
+	if e.SubmittedToCollab && e.SubmittedToCollabAt != nil && !e.hasEvent(ReviewEventForwarded) {
+		events = append(events, ReviewEvent{
+			Type:   ReviewEventForwarded,
+			Actor:  e.SubmittedToCollabBy,
+			At:     *e.SubmittedToCollabAt,
+			Status: ReviewStatusForwarded,
+		})
+	}
+	return append(events, e.Events...)
+}
+
+// hasEvent reports whether an event of the given type was recorded
+func (e *ReviewHistoryEntry) hasEvent(eventType ReviewEventType) bool {
+	for _, event := range e.Events {
+		if event.Type == eventType {
+			return true
+		}
+	}
+	return false
+}
+
+// CurrentStatus returns the lifecycle status, deriving it for entries saved before statuses existed
+func (e *ReviewHistoryEntry) CurrentStatus() ReviewStatus {
+	if e.Status != "" {
+		return e.Status
+	}
+
+	switch {
+	case e.ApprovedByArchitect:
+		return ReviewStatusArchitectApproved
+	case e.SubmittedToCollab:
+		return ReviewStatusForwarded
+	case e.ApprovedByTechLead:
+		return ReviewStatusTechLeadApproved
+	default:
+		return ReviewStatusSubmitted
+	}
 }
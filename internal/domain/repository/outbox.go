@@ -0,0 +1,25 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/yatbfi/cool/internal/domain/entity"
+)
+
+// OutboxRepository defines the interface for persisting queued notifications
+type OutboxRepository interface {
+	// Save saves new outbox messages
+	Save(ctx context.Context, messages ...*entity.OutboxMessage) error
+
+	// Update updates an existing outbox message
+	Update(ctx context.Context, message *entity.OutboxMessage) error
+
+	// FindByID retrieves an outbox message by ID
+	FindByID(ctx context.Context, id string) (*entity.OutboxMessage, error)
+
+	// FindAll retrieves all outbox messages, oldest first
+	FindAll(ctx context.Context) ([]*entity.OutboxMessage, error)
+
+	// Delete deletes an outbox message by ID
+	Delete(ctx context.Context, id string) error
+}
@@ -2,6 +2,7 @@ package repository
 
 import (
 	"context"
+	"time"
 
 	"github.com/yatbfi/cool/internal/domain/entity"
 )
@@ -17,12 +18,45 @@ type ReviewHistoryRepository interface {
 	// FindByID retrieves a review history entry by ID
 	FindByID(ctx context.Context, id string) (*entity.ReviewHistoryEntry, error)
 
+	// FindByIDPrefix retrieves the entries whose ID starts with the given prefix.
+	// An exact ID match is returned alone.
+	FindByIDPrefix(ctx context.Context, prefix string) ([]*entity.ReviewHistoryEntry, error)
+
 	// FindAll retrieves all review history entries
 	FindAll(ctx context.Context) ([]*entity.ReviewHistoryEntry, error)
 
 	// FindByCollabStatus retrieves review history entries filtered by collaboration status
 	FindByCollabStatus(ctx context.Context, submittedToCollab bool) ([]*entity.ReviewHistoryEntry, error)
 
+	// FindByCriteria retrieves the review history entries matching the criteria, sorted and limited
+	FindByCriteria(ctx context.Context, criteria ReviewHistoryCriteria) ([]*entity.ReviewHistoryEntry, error)
+
 	// Delete deletes a review history entry by ID
 	Delete(ctx context.Context, id string) error
 }
+
+// HistorySort defines the order of review history query results
+type HistorySort string
+
+const (
+	HistorySortNone      HistorySort = ""          // storage order
+	HistorySortSubmitted HistorySort = "submitted" // newest first
+	HistorySortPriority  HistorySort = "priority"  // most urgent first, then newest
+	HistorySortAge       HistorySort = "age"       // longest waiting first
+)
+
+// ReviewHistoryCriteria selects review history entries. Zero values match everything,
+// except that drafts are only matched when Drafts is set.
+type ReviewHistoryCriteria struct {
+	SubmittedToCollab *bool
+	Drafts            bool     // match drafts instead of submitted requests
+	Priorities        []string // e.g. "P0", "P1"
+	SubmittedSince    *time.Time
+	SubmittedUntil    *time.Time // exclusive
+	JiraKey           string     // ticket key ("ABC-123") or project ("ABC") of a Jira link
+	Repository        string     // repository path of a PR link, e.g. "org/name"
+	Search            string     // case-insensitive text in the title or description
+
+	Sort  HistorySort
+	Limit int // 0 for no limit
+}
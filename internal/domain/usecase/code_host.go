@@ -0,0 +1,450 @@
+package usecase
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/yatbfi/cool/config"
+	"github.com/yatbfi/cool/internal/domain/entity"
+	"github.com/yatbfi/cool/internal/pkg/common"
+)
+
+// codeHostTimeout bounds a single code host API request
+const codeHostTimeout = 10 * time.Second
+
+var (
+	// ErrUnsupportedCodeHost is returned for pull request links on hosts without a supported API
+	ErrUnsupportedCodeHost = errors.New("code host has no supported API")
+	// ErrNoCodeHostToken is returned when no API token is configured for the code host
+	ErrNoCodeHostToken = errors.New("no code host API token configured")
+)
+
+// PullRequestInfo is pull request metadata (alias from entity)
+type PullRequestInfo = entity.PullRequestInfo
+
+// CodeHostError is returned when the code host API rejects a request
+type CodeHostError struct {
+	StatusCode int
+	Message    string
+	// RateLimitReset is when a rate-limited request may be retried, zero when not rate limited
+	RateLimitReset time.Time
+}
+
+func (e *CodeHostError) Error() string {
+	if e.RateLimited() {
+		return fmt.Sprintf("code host API rate limit exceeded, retry after %s", e.RateLimitReset.Local().Format("2006-01-02 15:04:05"))
+	}
+	if e.Message != "" {
+		return fmt.Sprintf("code host API returned %d: %s", e.StatusCode, e.Message)
+	}
+	return fmt.Sprintf("code host API returned %d", e.StatusCode)
+}
+
+// RateLimited reports whether the request was rejected by the API rate limit
+func (e *CodeHostError) RateLimited() bool {
+	return !e.RateLimitReset.IsZero()
+}
+
+// CodeHost defines the code host (GitHub, GitLab) API usecase interface
+type CodeHost interface {
+	// GetPullRequest fetches the details and CI status of a pull or merge request link.
+	// It returns ErrUnsupportedCodeHost for hosts without a supported API and
+	// ErrNoCodeHostToken when no token is configured for the host.
+	GetPullRequest(ctx context.Context, link string) (*PullRequestInfo, error)
+
+	// FindOpenPullRequest returns the link of the open pull or merge request of a branch
+	// in the repository at host/repo, or "" when there is none. It returns the same
+	// errors as GetPullRequest.
+	FindOpenPullRequest(ctx context.Context, host, repo, branch string) (string, error)
+}
+
+// codeHostUsecase implements CodeHost interface
+type codeHostUsecase struct {
+	httpClient *http.Client
+	cfg        *config.Config
+
+	// cache keeps lookups, failed ones included, so previews and the final submit share
+	// one request per link and a rate limit is not hit again
+	mu    sync.Mutex
+	cache map[string]pullRequestLookup
+}
+
+type pullRequestLookup struct {
+	info *PullRequestInfo
+	err  error
+}
+
+// NewCodeHostUsecase creates a new code host usecase. The API URLs and tokens come from cfg,
+// so a stand-in server can be used by pointing github_api_url or gitlab_api_url at it.
+func NewCodeHostUsecase(httpClient *http.Client, cfg *config.Config) CodeHost {
+	if httpClient == nil {
+		httpClient = &http.Client{Timeout: codeHostTimeout}
+	}
+	return &codeHostUsecase{
+		httpClient: httpClient,
+		cfg:        cfg,
+		cache:      map[string]pullRequestLookup{},
+	}
+}
+
+// GetPullRequest fetches the details and CI status of a pull or merge request link
+func (u *codeHostUsecase) GetPullRequest(ctx context.Context, link string) (*PullRequestInfo, error) {
+	u.mu.Lock()
+	lookup, ok := u.cache[link]
+	u.mu.Unlock()
+	if !ok {
+		lookup.info, lookup.err = u.fetchPullRequest(ctx, link)
+		u.mu.Lock()
+		u.cache[link] = lookup
+		u.mu.Unlock()
+	}
+
+	if lookup.err != nil {
+		return nil, lookup.err
+	}
+	info := *lookup.info
+	return &info, nil
+}
+
+// fetchPullRequest looks up a pull request link on the API of its code host
+func (u *codeHostUsecase) fetchPullRequest(ctx context.Context, link string) (*PullRequestInfo, error) {
+	parsed, err := url.Parse(link)
+	if err != nil {
+		return nil, fmt.Errorf("parse pull request link: %w", err)
+	}
+	api, err := u.hostAPI(parsed.Hostname())
+	if err != nil {
+		return nil, err
+	}
+
+	repo := common.ExtractRepository(link)
+	number, err := strconv.Atoi(common.ExtractPRNumber(link))
+	if repo == "" || err != nil {
+		return nil, fmt.Errorf("invalid pull request link %q", link)
+	}
+
+	var info *PullRequestInfo
+	switch api.Kind {
+	case config.CodeHostGitHub:
+		info, err = u.fetchGitHub(ctx, api, repo, number)
+	default:
+		info, err = u.fetchGitLab(ctx, api, repo, number)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("get %s#%d: %w", repo, number, err)
+	}
+	info.Link = link
+	info.Repository = repo
+	info.Number = number
+	info.FetchedAt = time.Now()
+	return info, nil
+}
+
+// FindOpenPullRequest returns the link of the open pull or merge request of a branch
+func (u *codeHostUsecase) FindOpenPullRequest(ctx context.Context, host, repo, branch string) (string, error) {
+	api, err := u.hostAPI(host)
+	if err != nil {
+		return "", err
+	}
+
+	var link string
+	switch api.Kind {
+	case config.CodeHostGitHub:
+		// head is "owner:branch"; pull requests from forks are not found
+		owner, _, _ := strings.Cut(repo, "/")
+		query := url.Values{"state": {"open"}, "head": {owner + ":" + branch}}
+		var pulls []struct {
+			Number int `json:"number"`
+		}
+		err = u.getJSON(ctx, api, api.BaseURL+"/repos/"+repo+"/pulls?"+query.Encode(), &pulls)
+		if err == nil && len(pulls) > 0 {
+			link = fmt.Sprintf("https://%s/%s/pull/%d", host, repo, pulls[0].Number)
+		}
+	default:
+		query := url.Values{"state": {"opened"}, "source_branch": {branch}}
+		var mrs []struct {
+			IID int `json:"iid"`
+		}
+		err = u.getJSON(ctx, api, api.BaseURL+"/projects/"+url.PathEscape(repo)+"/merge_requests?"+query.Encode(), &mrs)
+		if err == nil && len(mrs) > 0 {
+			link = fmt.Sprintf("https://%s/%s/-/merge_requests/%d", host, repo, mrs[0].IID)
+		}
+	}
+	if err != nil {
+		return "", fmt.Errorf("find pull request of %s in %s: %w", branch, repo, err)
+	}
+	return link, nil
+}
+
+// hostAPI returns the API of a code host, failing when it has none or no token is set
+func (u *codeHostUsecase) hostAPI(host string) (config.CodeHostAPI, error) {
+	api, ok := u.cfg.CodeHostAPI(host)
+	if !ok {
+		return api, ErrUnsupportedCodeHost
+	}
+	if api.Token == "" {
+		return api, fmt.Errorf("%w: set %s_token in ~/.cool-cli/config.json or %s_TOKEN", ErrNoCodeHostToken, api.Kind, strings.ToUpper(api.Kind))
+	}
+	return api, nil
+}
+
+// fetchGitHub reads a pull request and the checks and statuses of its head commit
+func (u *codeHostUsecase) fetchGitHub(ctx context.Context, api config.CodeHostAPI, repo string, number int) (*PullRequestInfo, error) {
+	base := api.BaseURL + "/repos/" + repo
+
+	var pr struct {
+		Title string `json:"title"`
+		User  struct {
+			Login string `json:"login"`
+		} `json:"user"`
+		State        string `json:"state"`
+		Merged       bool   `json:"merged"`
+		Draft        bool   `json:"draft"`
+		Additions    int    `json:"additions"`
+		Deletions    int    `json:"deletions"`
+		ChangedFiles int    `json:"changed_files"`
+		Head         struct {
+			SHA string `json:"sha"`
+		} `json:"head"`
+	}
+	if err := u.getJSON(ctx, api, fmt.Sprintf("%s/pulls/%d", base, number), &pr); err != nil {
+		return nil, err
+	}
+
+	info := &PullRequestInfo{
+		Title:        pr.Title,
+		Author:       pr.User.Login,
+		State:        entity.PullRequestStateOpen,
+		Draft:        pr.Draft,
+		Additions:    pr.Additions,
+		Deletions:    pr.Deletions,
+		ChangedFiles: pr.ChangedFiles,
+	}
+	switch {
+	case pr.Merged:
+		info.State = entity.PullRequestStateMerged
+	case pr.State == "closed":
+		info.State = entity.PullRequestStateClosed
+	}
+
+	// CI is informational; a failed lookup leaves it unknown rather than failing the request
+	if pr.Head.SHA != "" {
+		var results []entity.CIStatus
+
+		var combined struct {
+			State      string `json:"state"`
+			TotalCount int    `json:"total_count"`
+		}
+		if err := u.getJSON(ctx, api, base+"/commits/"+pr.Head.SHA+"/status", &combined); err == nil && combined.TotalCount > 0 {
+			results = append(results, githubStatusResult(combined.State))
+		}
+
+		var checks struct {
+			CheckRuns []struct {
+				Status     string `json:"status"`
+				Conclusion string `json:"conclusion"`
+			} `json:"check_runs"`
+		}
+		if err := u.getJSON(ctx, api, base+"/commits/"+pr.Head.SHA+"/check-runs", &checks); err == nil {
+			for _, run := range checks.CheckRuns {
+				results = append(results, githubCheckRunResult(run.Status, run.Conclusion))
+			}
+		}
+		info.CIStatus = combineCIStatus(results)
+	}
+
+	return info, nil
+}
+
+// fetchGitLab reads a merge request, its diff size and its head pipeline
+func (u *codeHostUsecase) fetchGitLab(ctx context.Context, api config.CodeHostAPI, repo string, number int) (*PullRequestInfo, error) {
+	base := fmt.Sprintf("%s/projects/%s/merge_requests/%d", api.BaseURL, url.PathEscape(repo), number)
+
+	var mr struct {
+		Title  string `json:"title"`
+		Author struct {
+			Username string `json:"username"`
+		} `json:"author"`
+		State          string `json:"state"`
+		Draft          bool   `json:"draft"`
+		WorkInProgress bool   `json:"work_in_progress"`
+		HeadPipeline   *struct {
+			Status string `json:"status"`
+		} `json:"head_pipeline"`
+	}
+	if err := u.getJSON(ctx, api, base, &mr); err != nil {
+		return nil, err
+	}
+
+	info := &PullRequestInfo{
+		Title:  mr.Title,
+		Author: mr.Author.Username,
+		State:  entity.PullRequestStateOpen,
+		Draft:  mr.Draft || mr.WorkInProgress,
+	}
+	switch mr.State {
+	case "merged":
+		info.State = entity.PullRequestStateMerged
+	case "closed":
+		info.State = entity.PullRequestStateClosed
+	}
+	if mr.HeadPipeline != nil {
+		info.CIStatus = gitlabPipelineResult(mr.HeadPipeline.Status)
+	}
+
+	// Merge requests carry no line counts; count them from the diffs
+	var changes struct {
+		Changes []struct {
+			Diff string `json:"diff"`
+		} `json:"changes"`
+	}
+	if err := u.getJSON(ctx, api, base+"/changes", &changes); err == nil {
+		info.ChangedFiles = len(changes.Changes)
+		for _, change := range changes.Changes {
+			for _, line := range strings.Split(change.Diff, "\n") {
+				switch {
+				case strings.HasPrefix(line, "+"):
+					info.Additions++
+				case strings.HasPrefix(line, "-"):
+					info.Deletions++
+				}
+			}
+		}
+	}
+
+	return info, nil
+}
+
+// getJSON sends an authenticated GET request and decodes the JSON response into v
+func (u *codeHostUsecase) getJSON(ctx context.Context, api config.CodeHostAPI, target string, v interface{}) error {
+	req, err := http.NewRequestWithContext(ctx, "GET", target, nil)
+	if err != nil {
+		return fmt.Errorf("create request: %w", err)
+	}
+
+	switch api.Kind {
+	case config.CodeHostGitHub:
+		req.Header.Set("Accept", "application/vnd.github+json")
+		req.Header.Set("Authorization", "Bearer "+api.Token)
+	default:
+		req.Header.Set("Accept", "application/json")
+		req.Header.Set("PRIVATE-TOKEN", api.Token)
+	}
+
+	resp, err := u.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("send request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return newCodeHostError(resp, time.Now())
+	}
+	if err := json.NewDecoder(resp.Body).Decode(v); err != nil {
+		return fmt.Errorf("decode response: %w", err)
+	}
+	return nil
+}
+
+// newCodeHostError builds a CodeHostError from a rejected response. GitHub reports an
+// exhausted rate limit with 403 or 429 and X-RateLimit-Remaining: 0, GitLab with 429.
+func newCodeHostError(resp *http.Response, now time.Time) *CodeHostError {
+	e := &CodeHostError{StatusCode: resp.StatusCode}
+
+	var body struct {
+		Message string `json:"message"`
+	}
+	data, _ := io.ReadAll(io.LimitReader(resp.Body, 64<<10))
+	if json.Unmarshal(data, &body) == nil {
+		e.Message = body.Message
+	}
+
+	exhausted := resp.Header.Get("X-RateLimit-Remaining") == "0" || resp.Header.Get("RateLimit-Remaining") == "0"
+	if resp.StatusCode == http.StatusTooManyRequests || (resp.StatusCode == http.StatusForbidden && exhausted) {
+		e.RateLimitReset = now.Add(time.Minute)
+		if wait := parseRetryAfter(resp.Header.Get("Retry-After"), now); wait > 0 {
+			e.RateLimitReset = now.Add(wait)
+		} else if reset := firstHeader(resp.Header, "X-RateLimit-Reset", "RateLimit-Reset"); reset != "" {
+			if seconds, err := strconv.ParseInt(reset, 10, 64); err == nil && seconds > now.Unix() {
+				e.RateLimitReset = time.Unix(seconds, 0)
+			}
+		}
+	}
+	return e
+}
+
+func firstHeader(header http.Header, names ...string) string {
+	for _, name := range names {
+		if value := header.Get(name); value != "" {
+			return value
+		}
+	}
+	return ""
+}
+
+// githubStatusResult maps a combined commit status state
+func githubStatusResult(state string) entity.CIStatus {
+	switch state {
+	case "success":
+		return entity.CIStatusSuccess
+	case "failure", "error":
+		return entity.CIStatusFailure
+	default:
+		return entity.CIStatusPending
+	}
+}
+
+// githubCheckRunResult maps a check run; neutral and skipped runs do not count
+func githubCheckRunResult(status, conclusion string) entity.CIStatus {
+	if status != "completed" {
+		return entity.CIStatusPending
+	}
+	switch conclusion {
+	case "success":
+		return entity.CIStatusSuccess
+	case "neutral", "skipped":
+		return entity.CIStatusNone
+	default:
+		return entity.CIStatusFailure
+	}
+}
+
+// gitlabPipelineResult maps a pipeline status; skipped and manual pipelines do not count
+func gitlabPipelineResult(status string) entity.CIStatus {
+	switch status {
+	case "success":
+		return entity.CIStatusSuccess
+	case "failed", "canceled":
+		return entity.CIStatusFailure
+	case "skipped", "manual", "":
+		return entity.CIStatusNone
+	default:
+		return entity.CIStatusPending
+	}
+}
+
+// combineCIStatus reduces check results to one status: any failure fails, then any
+// pending check keeps it pending
+func combineCIStatus(results []entity.CIStatus) entity.CIStatus {
+	combined := entity.CIStatusNone
+	for _, result := range results {
+		switch {
+		case result == entity.CIStatusFailure:
+			return entity.CIStatusFailure
+		case result == entity.CIStatusPending:
+			combined = entity.CIStatusPending
+		case result == entity.CIStatusSuccess && combined == entity.CIStatusNone:
+			combined = entity.CIStatusSuccess
+		}
+	}
+	return combined
+}
@@ -0,0 +1,392 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/yatbfi/cool/config"
+	"github.com/yatbfi/cool/internal/domain/entity"
+)
+
+const (
+	testGitHubLink = "https://github.com/org/pay/pull/42"
+	testGitLabLink = "https://gitlab.com/grp/svc/-/merge_requests/7"
+)
+
+// newCodeHostStub serves the given JSON bodies by escaped request path and counts requests.
+// Unknown paths get a 404.
+func newCodeHostStub(t *testing.T, routes map[string]string) (*httptest.Server, *int32) {
+	t.Helper()
+	var requests int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&requests, 1)
+		body, ok := routes[r.URL.EscapedPath()]
+		if !ok {
+			http.Error(w, `{"message":"Not Found"}`, http.StatusNotFound)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, body)
+	}))
+	t.Cleanup(srv.Close)
+	return srv, &requests
+}
+
+func TestGetPullRequestGitHub(t *testing.T) {
+	tests := []struct {
+		name      string
+		pr        string
+		status    string
+		checks    string
+		wantState entity.PullRequestState
+		wantCI    entity.CIStatus
+	}{
+		{
+			name:      "open with passing checks",
+			pr:        `{"state":"open","head":{"sha":"abc"}}`,
+			status:    `{"state":"success","total_count":1}`,
+			checks:    `{"check_runs":[{"status":"completed","conclusion":"success"},{"status":"completed","conclusion":"skipped"}]}`,
+			wantState: entity.PullRequestStateOpen,
+			wantCI:    entity.CIStatusSuccess,
+		},
+		{
+			name:      "open with a running check",
+			pr:        `{"state":"open","head":{"sha":"abc"}}`,
+			status:    `{"state":"pending","total_count":0}`,
+			checks:    `{"check_runs":[{"status":"completed","conclusion":"success"},{"status":"in_progress"}]}`,
+			wantState: entity.PullRequestStateOpen,
+			wantCI:    entity.CIStatusPending,
+		},
+		{
+			name:      "merged with a failed status",
+			pr:        `{"state":"closed","merged":true,"head":{"sha":"abc"}}`,
+			status:    `{"state":"failure","total_count":2}`,
+			checks:    `{"check_runs":[{"status":"in_progress"}]}`,
+			wantState: entity.PullRequestStateMerged,
+			wantCI:    entity.CIStatusFailure,
+		},
+		{
+			name:      "closed without checks",
+			pr:        `{"state":"closed","merged":false,"head":{"sha":"abc"}}`,
+			status:    `{"state":"pending","total_count":0}`,
+			checks:    `{"check_runs":[]}`,
+			wantState: entity.PullRequestStateClosed,
+			wantCI:    entity.CIStatusNone,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			srv, _ := newCodeHostStub(t, map[string]string{
+				"/repos/org/pay/pulls/42":               tt.pr,
+				"/repos/org/pay/commits/abc/status":     tt.status,
+				"/repos/org/pay/commits/abc/check-runs": tt.checks,
+			})
+			uc := NewCodeHostUsecase(srv.Client(), &config.Config{GitHubToken: "tok", GitHubAPIURL: srv.URL})
+
+			info, err := uc.GetPullRequest(context.Background(), testGitHubLink)
+			if err != nil {
+				t.Fatalf("GetPullRequest() error = %v", err)
+			}
+			if info.State != tt.wantState {
+				t.Errorf("State = %q, want %q", info.State, tt.wantState)
+			}
+			if info.CIStatus != tt.wantCI {
+				t.Errorf("CIStatus = %q, want %q", info.CIStatus, tt.wantCI)
+			}
+		})
+	}
+}
+
+func TestGetPullRequestGitHubDetails(t *testing.T) {
+	var auth string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		auth = r.Header.Get("Authorization")
+		fmt.Fprint(w, `{"title":"Add retry","user":{"login":"jane"},"state":"open","draft":true,"additions":10,"deletions":2,"changed_files":3}`)
+	}))
+	defer srv.Close()
+	uc := NewCodeHostUsecase(srv.Client(), &config.Config{GitHubToken: "tok", GitHubAPIURL: srv.URL})
+
+	info, err := uc.GetPullRequest(context.Background(), testGitHubLink)
+	if err != nil {
+		t.Fatalf("GetPullRequest() error = %v", err)
+	}
+	if auth != "Bearer tok" {
+		t.Errorf("Authorization = %q, want %q", auth, "Bearer tok")
+	}
+	want := PullRequestInfo{
+		Link:         testGitHubLink,
+		Repository:   "org/pay",
+		Number:       42,
+		Title:        "Add retry",
+		Author:       "jane",
+		State:        entity.PullRequestStateOpen,
+		Draft:        true,
+		Additions:    10,
+		Deletions:    2,
+		ChangedFiles: 3,
+	}
+	info.FetchedAt = time.Time{}
+	if *info != want {
+		t.Errorf("GetPullRequest() = %+v, want %+v", *info, want)
+	}
+}
+
+func TestGetPullRequestGitLab(t *testing.T) {
+	tests := []struct {
+		name      string
+		mr        string
+		wantState entity.PullRequestState
+		wantCI    entity.CIStatus
+		wantDraft bool
+	}{
+		{
+			name:      "opened with running pipeline",
+			mr:        `{"state":"opened","head_pipeline":{"status":"running"}}`,
+			wantState: entity.PullRequestStateOpen,
+			wantCI:    entity.CIStatusPending,
+		},
+		{
+			name:      "merged with passed pipeline",
+			mr:        `{"state":"merged","head_pipeline":{"status":"success"}}`,
+			wantState: entity.PullRequestStateMerged,
+			wantCI:    entity.CIStatusSuccess,
+		},
+		{
+			name:      "closed with failed pipeline",
+			mr:        `{"state":"closed","head_pipeline":{"status":"failed"}}`,
+			wantState: entity.PullRequestStateClosed,
+			wantCI:    entity.CIStatusFailure,
+		},
+		{
+			name:      "work in progress without pipeline",
+			mr:        `{"state":"opened","work_in_progress":true,"head_pipeline":null}`,
+			wantState: entity.PullRequestStateOpen,
+			wantCI:    entity.CIStatusNone,
+			wantDraft: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			srv, _ := newCodeHostStub(t, map[string]string{
+				"/projects/grp%2Fsvc/merge_requests/7":         tt.mr,
+				"/projects/grp%2Fsvc/merge_requests/7/changes": `{"changes":[{"diff":"@@ -1 +1,2 @@\n-old\n+new\n+more\n"},{"diff":"+added\n"}]}`,
+			})
+			uc := NewCodeHostUsecase(srv.Client(), &config.Config{GitLabToken: "tok", GitLabAPIURL: srv.URL})
+
+			info, err := uc.GetPullRequest(context.Background(), testGitLabLink)
+			if err != nil {
+				t.Fatalf("GetPullRequest() error = %v", err)
+			}
+			if info.State != tt.wantState {
+				t.Errorf("State = %q, want %q", info.State, tt.wantState)
+			}
+			if info.CIStatus != tt.wantCI {
+				t.Errorf("CIStatus = %q, want %q", info.CIStatus, tt.wantCI)
+			}
+			if info.Draft != tt.wantDraft {
+				t.Errorf("Draft = %v, want %v", info.Draft, tt.wantDraft)
+			}
+			if info.Repository != "grp/svc" || info.Number != 7 {
+				t.Errorf("Repository, Number = %q, %d, want %q, %d", info.Repository, info.Number, "grp/svc", 7)
+			}
+			if info.Additions != 3 || info.Deletions != 1 || info.ChangedFiles != 2 {
+				t.Errorf("Additions, Deletions, ChangedFiles = %d, %d, %d, want 3, 1, 2", info.Additions, info.Deletions, info.ChangedFiles)
+			}
+		})
+	}
+}
+
+func TestGetPullRequestRateLimit(t *testing.T) {
+	reset := time.Now().Add(30 * time.Minute).Truncate(time.Second)
+
+	tests := []struct {
+		name          string
+		status        int
+		header        map[string]string
+		wantLimited   bool
+		wantResetNear time.Time
+	}{
+		{
+			name:          "github 403 with exhausted limit",
+			status:        http.StatusForbidden,
+			header:        map[string]string{"X-RateLimit-Remaining": "0", "X-RateLimit-Reset": strconv.FormatInt(reset.Unix(), 10)},
+			wantLimited:   true,
+			wantResetNear: reset,
+		},
+		{
+			name:          "429 with retry-after",
+			status:        http.StatusTooManyRequests,
+			header:        map[string]string{"Retry-After": "120"},
+			wantLimited:   true,
+			wantResetNear: time.Now().Add(2 * time.Minute),
+		},
+		{
+			name:          "429 without headers",
+			status:        http.StatusTooManyRequests,
+			wantLimited:   true,
+			wantResetNear: time.Now().Add(time.Minute),
+		},
+		{
+			name:   "403 without rate limit headers",
+			status: http.StatusForbidden,
+			header: map[string]string{"X-RateLimit-Remaining": "4999"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var requests int32
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				atomic.AddInt32(&requests, 1)
+				for k, v := range tt.header {
+					w.Header().Set(k, v)
+				}
+				w.WriteHeader(tt.status)
+				fmt.Fprint(w, `{"message":"API rate limit exceeded"}`)
+			}))
+			defer srv.Close()
+			uc := NewCodeHostUsecase(srv.Client(), &config.Config{GitHubToken: "tok", GitHubAPIURL: srv.URL})
+
+			_, err := uc.GetPullRequest(context.Background(), testGitHubLink)
+			var hostErr *CodeHostError
+			if !errors.As(err, &hostErr) {
+				t.Fatalf("GetPullRequest() error = %v, want a *CodeHostError", err)
+			}
+			if hostErr.StatusCode != tt.status {
+				t.Errorf("StatusCode = %d, want %d", hostErr.StatusCode, tt.status)
+			}
+			if hostErr.RateLimited() != tt.wantLimited {
+				t.Fatalf("RateLimited() = %v, want %v", hostErr.RateLimited(), tt.wantLimited)
+			}
+			if !tt.wantLimited {
+				if hostErr.Message != "API rate limit exceeded" {
+					t.Errorf("Message = %q, want the API message", hostErr.Message)
+				}
+				return
+			}
+			if diff := hostErr.RateLimitReset.Sub(tt.wantResetNear); diff < -5*time.Second || diff > 5*time.Second {
+				t.Errorf("RateLimitReset = %s, want about %s", hostErr.RateLimitReset, tt.wantResetNear)
+			}
+
+			// Failed lookups are cached so the rate limit is not hit again
+			if _, err := uc.GetPullRequest(context.Background(), testGitHubLink); err == nil {
+				t.Error("second GetPullRequest() error = nil, want the cached error")
+			}
+			if n := atomic.LoadInt32(&requests); n != 1 {
+				t.Errorf("requests = %d, want 1", n)
+			}
+		})
+	}
+}
+
+func TestGetPullRequestWithoutToken(t *testing.T) {
+	t.Setenv("GITHUB_TOKEN", "")
+	t.Setenv("GITLAB_TOKEN", "")
+	srv, requests := newCodeHostStub(t, nil)
+	uc := NewCodeHostUsecase(srv.Client(), &config.Config{GitHubAPIURL: srv.URL, GitLabAPIURL: srv.URL})
+
+	for _, link := range []string{testGitHubLink, testGitLabLink} {
+		if _, err := uc.GetPullRequest(context.Background(), link); !errors.Is(err, ErrNoCodeHostToken) {
+			t.Errorf("GetPullRequest(%s) error = %v, want ErrNoCodeHostToken", link, err)
+		}
+	}
+	if _, err := uc.GetPullRequest(context.Background(), "https://bitbucket.org/org/pay/pull-requests/1"); !errors.Is(err, ErrUnsupportedCodeHost) {
+		t.Errorf("GetPullRequest(bitbucket) error = %v, want ErrUnsupportedCodeHost", err)
+	}
+	if n := atomic.LoadInt32(requests); n != 0 {
+		t.Errorf("requests = %d, want 0", n)
+	}
+}
+
+func TestGetPullRequestFallsBackToTokenEnv(t *testing.T) {
+	t.Setenv("GITHUB_TOKEN", "env-tok")
+	var auth string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		auth = r.Header.Get("Authorization")
+		fmt.Fprint(w, `{"state":"open"}`)
+	}))
+	defer srv.Close()
+	uc := NewCodeHostUsecase(srv.Client(), &config.Config{GitHubAPIURL: srv.URL})
+
+	if _, err := uc.GetPullRequest(context.Background(), testGitHubLink); err != nil {
+		t.Fatalf("GetPullRequest() error = %v", err)
+	}
+	if auth != "Bearer env-tok" {
+		t.Errorf("Authorization = %q, want %q", auth, "Bearer env-tok")
+	}
+}
+
+func TestFindOpenPullRequest(t *testing.T) {
+	tests := []struct {
+		name     string
+		cfg      func(url string) *config.Config
+		host     string
+		path     string
+		query    string
+		body     string
+		wantLink string
+	}{
+		{
+			name:     "github",
+			cfg:      func(url string) *config.Config { return &config.Config{GitHubToken: "tok", GitHubAPIURL: url} },
+			host:     "github.com",
+			path:     "/repos/org/pay/pulls",
+			query:    "head=org%3Afeature%2FPAY-1-retry&state=open",
+			body:     `[{"number":42}]`,
+			wantLink: "https://github.com/org/pay/pull/42",
+		},
+		{
+			name:     "gitlab",
+			cfg:      func(url string) *config.Config { return &config.Config{GitLabToken: "tok", GitLabAPIURL: url} },
+			host:     "gitlab.com",
+			path:     "/projects/org%2Fpay/merge_requests",
+			query:    "source_branch=feature%2FPAY-1-retry&state=opened",
+			body:     `[{"iid":7}]`,
+			wantLink: "https://gitlab.com/org/pay/-/merge_requests/7",
+		},
+		{
+			name:  "no open pull request",
+			cfg:   func(url string) *config.Config { return &config.Config{GitHubToken: "tok", GitHubAPIURL: url} },
+			host:  "github.com",
+			path:  "/repos/org/pay/pulls",
+			query: "head=org%3Afeature%2FPAY-1-retry&state=open",
+			body:  `[]`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var query string
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.URL.EscapedPath() != tt.path {
+					http.NotFound(w, r)
+					return
+				}
+				query = r.URL.RawQuery
+				fmt.Fprint(w, tt.body)
+			}))
+			defer srv.Close()
+			uc := NewCodeHostUsecase(srv.Client(), tt.cfg(srv.URL))
+
+			link, err := uc.FindOpenPullRequest(context.Background(), tt.host, "org/pay", "feature/PAY-1-retry")
+			if err != nil {
+				t.Fatalf("FindOpenPullRequest() error = %v", err)
+			}
+			if link != tt.wantLink {
+				t.Errorf("FindOpenPullRequest() = %q, want %q", link, tt.wantLink)
+			}
+			if query != tt.query {
+				t.Errorf("query = %q, want %q", query, tt.query)
+			}
+		})
+	}
+}
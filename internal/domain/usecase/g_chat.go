@@ -6,12 +6,29 @@ import (
 	"encoding/json"
 	"fmt"
 	"net/http"
+	"net/url"
 )
 
+// GChatThread identifies the thread a message should be posted in
+type GChatThread struct {
+	Key  string // client-assigned thread key, creates the thread on first use
+	Name string // server-assigned thread name (spaces/.../threads/...), preferred when known
+}
+
+// GChatMessage describes a message created by Google Chat
+type GChatMessage struct {
+	Name       string
+	ThreadName string
+}
+
 // GChat defines the Google Chat usecase interface
 type GChat interface {
 	// SendMessage sends a message to Google Chat webhook
 	SendMessage(ctx context.Context, webhookURL string, message string) error
+
+	// SendThreadMessage sends a text and/or card payload as a reply in a thread
+	// (starting it if needed) and returns the created message
+	SendThreadMessage(ctx context.Context, webhookURL string, payload *GChatPayload, thread GChatThread) (*GChatMessage, error)
 }
 
 // gchatUsecase implements GChat interface
@@ -28,35 +45,86 @@ func NewGChatUsecase() GChat {
 
 // SendMessage sends a message to Google Chat webhook
 func (u *gchatUsecase) SendMessage(ctx context.Context, webhookURL string, message string) error {
+	_, err := u.SendThreadMessage(ctx, webhookURL, &GChatPayload{Text: message}, GChatThread{})
+	return err
+}
+
+// SendThreadMessage sends a text and/or card payload as a reply in a thread
+// (starting it if needed) and returns the created message
+func (u *gchatUsecase) SendThreadMessage(ctx context.Context, webhookURL string, payload *GChatPayload, thread GChatThread) (*GChatMessage, error) {
 	if webhookURL == "" {
-		return fmt.Errorf("webhook URL is empty")
+		return nil, fmt.Errorf("webhook URL is empty")
+	}
+
+	body := map[string]interface{}{}
+	if payload.Text != "" {
+		body["text"] = payload.Text
 	}
+	if len(payload.CardsV2) > 0 {
+		body["cardsV2"] = payload.CardsV2
+	}
+
+	targetURL := webhookURL
+	if thread.Name != "" || thread.Key != "" {
+		if thread.Name != "" {
+			body["thread"] = map[string]string{"name": thread.Name}
+		} else {
+			body["thread"] = map[string]string{"threadKey": thread.Key}
+		}
 
-	payload := map[string]interface{}{
-		"text": message,
+		var err error
+		targetURL, err = withReplyOption(webhookURL)
+		if err != nil {
+			return nil, err
+		}
 	}
 
-	jsonData, err := json.Marshal(payload)
+	jsonData, err := json.Marshal(body)
 	if err != nil {
-		return fmt.Errorf("marshal payload: %w", err)
+		return nil, fmt.Errorf("marshal payload: %w", err)
 	}
 
-	req, err := http.NewRequestWithContext(ctx, "POST", webhookURL, bytes.NewBuffer(jsonData))
+	req, err := http.NewRequestWithContext(ctx, "POST", targetURL, bytes.NewBuffer(jsonData))
 	if err != nil {
-		return fmt.Errorf("create request: %w", err)
+		return nil, fmt.Errorf("create request: %w", err)
 	}
 
 	req.Header.Set("Content-Type", "application/json")
 
 	resp, err := u.httpClient.Do(req)
 	if err != nil {
-		return fmt.Errorf("send request: %w", err)
+		return nil, fmt.Errorf("send request: %w", err)
 	}
 	defer resp.Body.Close()
 
 	if resp.StatusCode != http.StatusOK {
-		return fmt.Errorf("unexpected status code: %d", resp.StatusCode)
+		return nil, newDeliveryError(resp)
+	}
+
+	// The created message is optional for our purposes, so a malformed body is not an error
+	var created struct {
+		Name   string `json:"name"`
+		Thread struct {
+			Name string `json:"name"`
+		} `json:"thread"`
+	}
+	_ = json.NewDecoder(resp.Body).Decode(&created)
+
+	return &GChatMessage{
+		Name:       created.Name,
+		ThreadName: created.Thread.Name,
+	}, nil
+}
+
+// withReplyOption makes the webhook reply in the given thread, falling back to a new thread
+func withReplyOption(webhookURL string) (string, error) {
+	u, err := url.Parse(webhookURL)
+	if err != nil {
+		return "", fmt.Errorf("parse webhook URL: %w", err)
 	}
 
-	return nil
+	q := u.Query()
+	q.Set("messageReplyOption", "REPLY_MESSAGE_FALLBACK_TO_NEW_THREAD")
+	u.RawQuery = q.Encode()
+	return u.String(), nil
 }
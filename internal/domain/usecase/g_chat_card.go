@@ -0,0 +1,159 @@
+package usecase
+
+import (
+	"fmt"
+	"html"
+	"strings"
+)
+
+// GChatPayload is the body of an outbound Google Chat message
+type GChatPayload struct {
+	Text    string        `json:"text,omitempty"`
+	CardsV2 []GChatCardV2 `json:"cardsV2,omitempty"`
+}
+
+// GChatCardV2 is a Google Chat Cards v2 card with its identifier
+type GChatCardV2 struct {
+	CardID string    `json:"cardId"`
+	Card   GChatCard `json:"card"`
+}
+
+// GChatCard is the content of a Cards v2 card
+type GChatCard struct {
+	Header   *GChatCardHeader   `json:"header,omitempty"`
+	Sections []GChatCardSection `json:"sections"`
+}
+
+// GChatCardHeader is the header of a card
+type GChatCardHeader struct {
+	Title    string `json:"title"`
+	Subtitle string `json:"subtitle,omitempty"`
+}
+
+// GChatCardSection groups widgets under an optional header
+type GChatCardSection struct {
+	Header  string        `json:"header,omitempty"`
+	Widgets []GChatWidget `json:"widgets"`
+}
+
+// GChatWidget is a single card widget; exactly one field should be set
+type GChatWidget struct {
+	TextParagraph *GChatTextParagraph `json:"textParagraph,omitempty"`
+	DecoratedText *GChatDecoratedText `json:"decoratedText,omitempty"`
+	ButtonList    *GChatButtonList    `json:"buttonList,omitempty"`
+}
+
+// GChatTextParagraph is a block of (HTML formatted) text
+type GChatTextParagraph struct {
+	Text string `json:"text"`
+}
+
+// GChatDecoratedText is a labelled line of text
+type GChatDecoratedText struct {
+	TopLabel string `json:"topLabel,omitempty"`
+	Text     string `json:"text"`
+}
+
+// GChatButtonList is a row of buttons
+type GChatButtonList struct {
+	Buttons []GChatButton `json:"buttons"`
+}
+
+// GChatButton opens a link when clicked
+type GChatButton struct {
+	Text    string       `json:"text"`
+	OnClick GChatOnClick `json:"onClick"`
+}
+
+// GChatOnClick is the action of a button
+type GChatOnClick struct {
+	OpenLink GChatOpenLink `json:"openLink"`
+}
+
+// GChatOpenLink is a link opened by a button
+type GChatOpenLink struct {
+	URL string `json:"url"`
+}
+
+// priorityBadges maps priorities to the badge shown in card headers
+var priorityBadges = map[string]string{
+	"P0": "🔴 P0 · Critical",
+	"P1": "🟠 P1 · High",
+	"P2": "🟡 P2 · Medium",
+	"P3": "🟢 P3 · Low",
+	"P4": "⚪ P4 · Very Low",
+}
+
+// PriorityBadge returns the badge label for a priority
+func PriorityBadge(priority string) string {
+	if badge, ok := priorityBadges[priority]; ok {
+		return badge
+	}
+	return priority
+}
+
+// buildReviewCard renders a rich notification as a card with description and link buttons
+func buildReviewCard(n *Notification) *GChatCardV2 {
+	entry := n.Entry
+
+	var details []GChatWidget
+	for _, field := range n.Fields {
+		details = append(details, decoratedText(field.Label, field.Value))
+	}
+	sections := []GChatCardSection{{Widgets: details}}
+
+	if entry.Description != "" {
+		sections = append(sections, GChatCardSection{
+			Header: "Description",
+			Widgets: []GChatWidget{{
+				TextParagraph: &GChatTextParagraph{Text: escapeCardText(entry.Description)},
+			}},
+		})
+	}
+
+	if len(entry.ReviewLinks) > 0 {
+		var buttons []GChatButton
+		for _, link := range entry.ReviewLinks {
+			buttons = append(buttons, linkButton(prLinkLabel(link), link))
+		}
+		sections = append(sections, GChatCardSection{
+			Header:  "Pull Requests",
+			Widgets: []GChatWidget{{ButtonList: &GChatButtonList{Buttons: buttons}}},
+		})
+	}
+
+	if len(entry.JiraLinks) > 0 {
+		var buttons []GChatButton
+		for _, link := range entry.JiraLinks {
+			buttons = append(buttons, linkButton(jiraLinkLabel(link), link))
+		}
+		sections = append(sections, GChatCardSection{
+			Header:  "Jira Tickets",
+			Widgets: []GChatWidget{{ButtonList: &GChatButtonList{Buttons: buttons}}},
+		})
+	}
+
+	return &GChatCardV2{
+		CardID: fmt.Sprintf("%s-%s", n.Kind, entry.ID),
+		Card: GChatCard{
+			Header: &GChatCardHeader{
+				Title:    entry.Title,
+				Subtitle: fmt.Sprintf("%s · %s", n.Headline, PriorityBadge(entry.Priority)),
+			},
+			Sections: sections,
+		},
+	}
+}
+
+func decoratedText(label, text string) GChatWidget {
+	return GChatWidget{DecoratedText: &GChatDecoratedText{TopLabel: label, Text: escapeCardText(text)}}
+}
+
+func linkButton(label, link string) GChatButton {
+	return GChatButton{Text: label, OnClick: GChatOnClick{OpenLink: GChatOpenLink{URL: link}}}
+}
+
+// escapeCardText escapes user input for card text, which is interpreted as HTML
+func escapeCardText(text string) string {
+	return strings.ReplaceAll(html.EscapeString(text), "\n", "<br>")
+}
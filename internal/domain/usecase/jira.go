@@ -0,0 +1,268 @@
+package usecase
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/yatbfi/cool/config"
+	"github.com/yatbfi/cool/internal/domain/entity"
+	"github.com/yatbfi/cool/internal/pkg/common"
+)
+
+// jiraTimeout bounds a single Jira API request
+const jiraTimeout = 10 * time.Second
+
+var (
+	// ErrJiraNotConfigured is returned when the Jira API cannot be used for a link
+	ErrJiraNotConfigured = errors.New("Jira API is not configured")
+	// ErrJiraIssueNotFound is returned for issues that do not exist (or are not visible to the user)
+	ErrJiraIssueNotFound = errors.New("Jira issue not found")
+)
+
+// JiraIssueInfo is Jira issue metadata (alias from entity)
+type JiraIssueInfo = entity.JiraIssueInfo
+
+// JiraError is returned when the Jira API rejects a request
+type JiraError struct {
+	StatusCode int
+	Messages   []string
+}
+
+func (e *JiraError) Error() string {
+	if len(e.Messages) > 0 {
+		return fmt.Sprintf("Jira API returned %d: %s", e.StatusCode, strings.Join(e.Messages, "; "))
+	}
+	return fmt.Sprintf("Jira API returned %d", e.StatusCode)
+}
+
+// Jira defines the Jira REST API usecase interface
+type Jira interface {
+	// GetIssue fetches the summary, status and assignee of a Jira issue link. It returns
+	// ErrJiraNotConfigured when no credentials are set or the link is not on jira_base_url,
+	// and ErrJiraIssueNotFound when the issue does not exist.
+	GetIssue(ctx context.Context, link string) (*JiraIssueInfo, error)
+
+	// TransitionIssue applies the workflow transition with the given name, or the one
+	// leading to the status of that name (case-insensitive)
+	TransitionIssue(ctx context.Context, key, transition string) error
+
+	// AddComment adds a plain-text comment to an issue
+	AddComment(ctx context.Context, key, body string) error
+}
+
+// jiraUsecase implements Jira interface
+type jiraUsecase struct {
+	httpClient *http.Client
+	cfg        *config.Config
+
+	// cache keeps lookups so previews and the final submit share one request per issue
+	mu    sync.Mutex
+	cache map[string]jiraIssueLookup
+}
+
+type jiraIssueLookup struct {
+	info *JiraIssueInfo
+	err  error
+}
+
+// NewJiraUsecase creates a new Jira usecase. The API is reached at jira_base_url of cfg,
+// so a stand-in server can be used by pointing it there.
+func NewJiraUsecase(httpClient *http.Client, cfg *config.Config) Jira {
+	if httpClient == nil {
+		httpClient = &http.Client{Timeout: jiraTimeout}
+	}
+	return &jiraUsecase{
+		httpClient: httpClient,
+		cfg:        cfg,
+		cache:      map[string]jiraIssueLookup{},
+	}
+}
+
+// GetIssue fetches the summary, status and assignee of a Jira issue link
+func (u *jiraUsecase) GetIssue(ctx context.Context, link string) (*JiraIssueInfo, error) {
+	u.mu.Lock()
+	lookup, ok := u.cache[link]
+	u.mu.Unlock()
+	if !ok {
+		lookup.info, lookup.err = u.fetchIssue(ctx, link)
+		u.mu.Lock()
+		u.cache[link] = lookup
+		u.mu.Unlock()
+	}
+
+	if lookup.err != nil {
+		return nil, lookup.err
+	}
+	info := *lookup.info
+	return &info, nil
+}
+
+func (u *jiraUsecase) fetchIssue(ctx context.Context, link string) (*JiraIssueInfo, error) {
+	if !u.cfg.JiraAPIConfigured() {
+		return nil, fmt.Errorf("%w: set jira_base_url and jira_token in ~/.cool-cli/config.json (or JIRA_API_TOKEN)", ErrJiraNotConfigured)
+	}
+
+	linkURL, err := url.Parse(link)
+	if err != nil {
+		return nil, fmt.Errorf("parse Jira link: %w", err)
+	}
+	baseURL, err := url.Parse(u.cfg.JiraBaseURL)
+	if err != nil {
+		return nil, fmt.Errorf("parse jira_base_url: %w", err)
+	}
+	// Credentials are only ever sent to the configured Jira
+	if !strings.EqualFold(linkURL.Host, baseURL.Host) {
+		return nil, fmt.Errorf("%w for %s, only jira_base_url is queried", ErrJiraNotConfigured, linkURL.Host)
+	}
+
+	key := common.ExtractJiraTicketNumber(link)
+	if key == "" {
+		return nil, fmt.Errorf("invalid Jira link %q", link)
+	}
+
+	var issue struct {
+		Key    string `json:"key"`
+		Fields struct {
+			Summary string `json:"summary"`
+			Status  struct {
+				Name string `json:"name"`
+			} `json:"status"`
+			Assignee *struct {
+				DisplayName string `json:"displayName"`
+			} `json:"assignee"`
+		} `json:"fields"`
+	}
+	err = u.do(ctx, "GET", "/rest/api/2/issue/"+url.PathEscape(key)+"?fields=summary,status,assignee", nil, &issue)
+	var jiraErr *JiraError
+	if errors.As(err, &jiraErr) && jiraErr.StatusCode == http.StatusNotFound {
+		return nil, fmt.Errorf("%w: %s", ErrJiraIssueNotFound, key)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("get %s: %w", key, err)
+	}
+
+	info := &JiraIssueInfo{
+		Link:      link,
+		Key:       key,
+		Summary:   issue.Fields.Summary,
+		Status:    issue.Fields.Status.Name,
+		FetchedAt: time.Now(),
+	}
+	if issue.Key != "" {
+		info.Key = issue.Key
+	}
+	if issue.Fields.Assignee != nil {
+		info.Assignee = issue.Fields.Assignee.DisplayName
+	}
+	return info, nil
+}
+
+// TransitionIssue applies the workflow transition with the given name, or the one
+// leading to the status of that name
+func (u *jiraUsecase) TransitionIssue(ctx context.Context, key, transition string) error {
+	var available struct {
+		Transitions []struct {
+			ID   string `json:"id"`
+			Name string `json:"name"`
+			To   struct {
+				Name string `json:"name"`
+			} `json:"to"`
+		} `json:"transitions"`
+	}
+	path := "/rest/api/2/issue/" + url.PathEscape(key) + "/transitions"
+	if err := u.do(ctx, "GET", path, nil, &available); err != nil {
+		return fmt.Errorf("get transitions of %s: %w", key, err)
+	}
+
+	for _, t := range available.Transitions {
+		if strings.EqualFold(t.Name, transition) || strings.EqualFold(t.To.Name, transition) {
+			body := map[string]interface{}{"transition": map[string]string{"id": t.ID}}
+			if err := u.do(ctx, "POST", path, body, nil); err != nil {
+				return fmt.Errorf("transition %s: %w", key, err)
+			}
+			return nil
+		}
+	}
+	return fmt.Errorf("transition %q is not available for %s", transition, key)
+}
+
+// AddComment adds a plain-text comment to an issue
+func (u *jiraUsecase) AddComment(ctx context.Context, key, body string) error {
+	path := "/rest/api/2/issue/" + url.PathEscape(key) + "/comment"
+	if err := u.do(ctx, "POST", path, map[string]string{"body": body}, nil); err != nil {
+		return fmt.Errorf("comment on %s: %w", key, err)
+	}
+	return nil
+}
+
+// do sends an authenticated API request with an optional JSON body and decodes the JSON
+// response into out when given
+func (u *jiraUsecase) do(ctx context.Context, method, path string, body, out interface{}) error {
+	var reader io.Reader
+	if body != nil {
+		data, err := json.Marshal(body)
+		if err != nil {
+			return fmt.Errorf("marshal request: %w", err)
+		}
+		reader = bytes.NewReader(data)
+	}
+
+	target := strings.TrimRight(u.cfg.JiraBaseURL, "/") + path
+	req, err := http.NewRequestWithContext(ctx, method, target, reader)
+	if err != nil {
+		return fmt.Errorf("create request: %w", err)
+	}
+	req.Header.Set("Accept", "application/json")
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	if user, token := u.cfg.JiraCredentials(); user != "" {
+		req.SetBasicAuth(user, token)
+	} else {
+		req.Header.Set("Authorization", "Bearer "+token)
+	}
+
+	resp, err := u.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("send request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return newJiraError(resp)
+	}
+	if out == nil {
+		return nil
+	}
+	if err := json.NewDecoder(resp.Body).Decode(out); err != nil {
+		return fmt.Errorf("decode response: %w", err)
+	}
+	return nil
+}
+
+// newJiraError builds a JiraError from a rejected response and its error messages
+func newJiraError(resp *http.Response) *JiraError {
+	e := &JiraError{StatusCode: resp.StatusCode}
+
+	var body struct {
+		ErrorMessages []string          `json:"errorMessages"`
+		Errors        map[string]string `json:"errors"`
+	}
+	data, _ := io.ReadAll(io.LimitReader(resp.Body, 64<<10))
+	if json.Unmarshal(data, &body) == nil {
+		e.Messages = append(e.Messages, body.ErrorMessages...)
+		for field, message := range body.Errors {
+			e.Messages = append(e.Messages, field+": "+message)
+		}
+	}
+	return e
+}
@@ -0,0 +1,252 @@
+package usecase
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+
+	"github.com/yatbfi/cool/config"
+)
+
+// newJiraStub runs handler as the Jira API and returns a config pointing at it
+func newJiraStub(t *testing.T, handler http.HandlerFunc) (*httptest.Server, *config.Config) {
+	t.Helper()
+	srv := httptest.NewServer(handler)
+	t.Cleanup(srv.Close)
+	return srv, &config.Config{JiraBaseURL: srv.URL, JiraToken: "tok"}
+}
+
+func TestGetIssue(t *testing.T) {
+	var auth, query string
+	srv, cfg := newJiraStub(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/rest/api/2/issue/PAY-12" {
+			http.NotFound(w, r)
+			return
+		}
+		auth = r.Header.Get("Authorization")
+		query = r.URL.RawQuery
+		fmt.Fprint(w, `{"key":"PAY-12","fields":{"summary":"Retry payouts","status":{"name":"In Review"},"assignee":{"displayName":"Jane Doe"}}}`)
+	})
+	link := srv.URL + "/browse/PAY-12"
+
+	info, err := NewJiraUsecase(srv.Client(), cfg).GetIssue(context.Background(), link)
+	if err != nil {
+		t.Fatalf("GetIssue() error = %v", err)
+	}
+	if auth != "Bearer tok" {
+		t.Errorf("Authorization = %q, want %q", auth, "Bearer tok")
+	}
+	if query != "fields=summary,status,assignee" {
+		t.Errorf("query = %q, want only the shown fields", query)
+	}
+	if info.Link != link || info.Key != "PAY-12" || info.Summary != "Retry payouts" || info.Status != "In Review" || info.Assignee != "Jane Doe" {
+		t.Errorf("GetIssue() = %+v", *info)
+	}
+}
+
+func TestGetIssueBasicAuth(t *testing.T) {
+	var user, token string
+	srv, cfg := newJiraStub(t, func(w http.ResponseWriter, r *http.Request) {
+		user, token, _ = r.BasicAuth()
+		fmt.Fprint(w, `{"key":"PAY-12","fields":{"summary":"Retry payouts","status":{"name":"To Do"},"assignee":null}}`)
+	})
+	cfg.JiraUser = "jane@example.com"
+
+	info, err := NewJiraUsecase(srv.Client(), cfg).GetIssue(context.Background(), srv.URL+"/browse/PAY-12")
+	if err != nil {
+		t.Fatalf("GetIssue() error = %v", err)
+	}
+	if user != "jane@example.com" || token != "tok" {
+		t.Errorf("BasicAuth() = %q, %q, want the configured user and token", user, token)
+	}
+	if info.Assignee != "" {
+		t.Errorf("Assignee = %q, want empty for an unassigned issue", info.Assignee)
+	}
+}
+
+func TestGetIssueErrors(t *testing.T) {
+	tests := []struct {
+		name       string
+		status     int
+		body       string
+		wantErr    error
+		wantStatus int
+		wantMsg    string
+	}{
+		{
+			name:    "not found",
+			status:  http.StatusNotFound,
+			body:    `{"errorMessages":["Issue does not exist or you do not have permission to see it."]}`,
+			wantErr: ErrJiraIssueNotFound,
+		},
+		{
+			name:       "unauthorized",
+			status:     http.StatusUnauthorized,
+			wantStatus: http.StatusUnauthorized,
+			wantMsg:    "Jira API returned 401",
+		},
+		{
+			name:       "rate limited",
+			status:     http.StatusTooManyRequests,
+			body:       `{"errorMessages":["Rate limit exceeded."]}`,
+			wantStatus: http.StatusTooManyRequests,
+			wantMsg:    "Jira API returned 429: Rate limit exceeded.",
+		},
+		{
+			name:       "field errors",
+			status:     http.StatusBadRequest,
+			body:       `{"errors":{"fields":"unknown field"}}`,
+			wantStatus: http.StatusBadRequest,
+			wantMsg:    "Jira API returned 400: fields: unknown field",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			srv, cfg := newJiraStub(t, func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(tt.status)
+				fmt.Fprint(w, tt.body)
+			})
+
+			_, err := NewJiraUsecase(srv.Client(), cfg).GetIssue(context.Background(), srv.URL+"/browse/PAY-404")
+			if err == nil {
+				t.Fatal("GetIssue() error = nil")
+			}
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Errorf("GetIssue() error = %v, want %v", err, tt.wantErr)
+				}
+				return
+			}
+			var jiraErr *JiraError
+			if !errors.As(err, &jiraErr) {
+				t.Fatalf("GetIssue() error = %v, want a *JiraError", err)
+			}
+			if jiraErr.StatusCode != tt.wantStatus {
+				t.Errorf("StatusCode = %d, want %d", jiraErr.StatusCode, tt.wantStatus)
+			}
+			if jiraErr.Error() != tt.wantMsg {
+				t.Errorf("Error() = %q, want %q", jiraErr.Error(), tt.wantMsg)
+			}
+		})
+	}
+}
+
+func TestGetIssueNotConfigured(t *testing.T) {
+	t.Setenv("JIRA_API_TOKEN", "")
+	t.Setenv("JIRA_USER", "")
+	var requests int32
+	srv, cfg := newJiraStub(t, func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&requests, 1)
+	})
+
+	tests := []struct {
+		name string
+		cfg  *config.Config
+		link string
+	}{
+		{name: "no token", cfg: &config.Config{JiraBaseURL: srv.URL}, link: srv.URL + "/browse/PAY-12"},
+		{name: "no base url", cfg: &config.Config{JiraToken: "tok"}, link: srv.URL + "/browse/PAY-12"},
+		{name: "other host", cfg: cfg, link: "https://other.atlassian.net/browse/PAY-12"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := NewJiraUsecase(srv.Client(), tt.cfg).GetIssue(context.Background(), tt.link)
+			if !errors.Is(err, ErrJiraNotConfigured) {
+				t.Errorf("GetIssue() error = %v, want ErrJiraNotConfigured", err)
+			}
+		})
+	}
+	if n := atomic.LoadInt32(&requests); n != 0 {
+		t.Errorf("requests = %d, want 0", n)
+	}
+}
+
+func TestGetIssueFallsBackToTokenEnv(t *testing.T) {
+	t.Setenv("JIRA_API_TOKEN", "env-tok")
+	t.Setenv("JIRA_USER", "")
+	var auth string
+	srv, _ := newJiraStub(t, func(w http.ResponseWriter, r *http.Request) {
+		auth = r.Header.Get("Authorization")
+		fmt.Fprint(w, `{"key":"PAY-12","fields":{}}`)
+	})
+
+	cfg := &config.Config{JiraBaseURL: srv.URL}
+	if _, err := NewJiraUsecase(srv.Client(), cfg).GetIssue(context.Background(), srv.URL+"/browse/PAY-12"); err != nil {
+		t.Fatalf("GetIssue() error = %v", err)
+	}
+	if auth != "Bearer env-tok" {
+		t.Errorf("Authorization = %q, want %q", auth, "Bearer env-tok")
+	}
+}
+
+func TestTransitionIssue(t *testing.T) {
+	var posted string
+	srv, cfg := newJiraStub(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/rest/api/2/issue/PAY-12/transitions" {
+			http.NotFound(w, r)
+			return
+		}
+		if r.Method == http.MethodPost {
+			var body struct {
+				Transition struct {
+					ID string `json:"id"`
+				} `json:"transition"`
+			}
+			if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+				t.Errorf("decode transition: %v", err)
+			}
+			posted = body.Transition.ID
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+		fmt.Fprint(w, `{"transitions":[{"id":"11","name":"Start review","to":{"name":"In Review"}},{"id":"31","name":"Finish","to":{"name":"Done"}}]}`)
+	})
+	uc := NewJiraUsecase(srv.Client(), cfg)
+
+	if err := uc.TransitionIssue(context.Background(), "PAY-12", "in review"); err != nil {
+		t.Fatalf("TransitionIssue() error = %v", err)
+	}
+	if posted != "11" {
+		t.Errorf("posted transition = %q, want %q", posted, "11")
+	}
+
+	posted = ""
+	if err := uc.TransitionIssue(context.Background(), "PAY-12", "Reopen"); err == nil {
+		t.Error("TransitionIssue(Reopen) error = nil, want an unavailable transition error")
+	}
+	if posted != "" {
+		t.Errorf("posted transition = %q, want none", posted)
+	}
+}
+
+func TestAddComment(t *testing.T) {
+	var method, comment string
+	srv, cfg := newJiraStub(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/rest/api/2/issue/PAY-12/comment" {
+			http.NotFound(w, r)
+			return
+		}
+		method = r.Method
+		var body struct {
+			Body string `json:"body"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			t.Errorf("decode comment: %v", err)
+		}
+		comment = body.Body
+		w.WriteHeader(http.StatusCreated)
+	})
+
+	if err := NewJiraUsecase(srv.Client(), cfg).AddComment(context.Background(), "PAY-12", "Review approved"); err != nil {
+		t.Fatalf("AddComment() error = %v", err)
+	}
+	if method != http.MethodPost || comment != "Review approved" {
+		t.Errorf("request = %s %q, want POST %q", method, comment, "Review approved")
+	}
+}
@@ -0,0 +1,196 @@
+package usecase
+
+import (
+	"embed"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+	"text/template"
+	"time"
+
+	"github.com/yatbfi/cool/config"
+	"github.com/yatbfi/cool/internal/pkg/common"
+)
+
+// Message template names. User templates are looked up as <name>.tmpl in the
+// project and user template directories (see config.TemplateDirs).
+const (
+	TemplateReviewRequest = "review_request"
+	TemplateCollaboration = "collaboration"
+)
+
+// TemplateNames lists the supported message templates
+var TemplateNames = []string{TemplateReviewRequest, TemplateCollaboration}
+
+//go:embed templates/*.tmpl
+var builtinTemplates embed.FS
+
+// MessageTemplateData is the data a message template is rendered with. All fields of
+// ReviewHistoryEntry (Title, Priority, Type, Description, ReviewLinks, JiraLinks, SubmittedBy, ...)
+// are available directly, e.g. {{.Title}}.
+type MessageTemplateData struct {
+	*ReviewHistoryEntry
+
+	// ForwardedAt is when the request is forwarded to collaboration (collaboration template only)
+	ForwardedAt time.Time
+}
+
+// MessageTemplate is a parsed message template and where it was loaded from
+type MessageTemplate struct {
+	Name string
+	Path string // empty for the built-in template
+
+	tmpl *template.Template
+}
+
+// IsBuiltin reports whether this is the built-in layout rather than a user template
+func (t *MessageTemplate) IsBuiltin() bool {
+	return t.Path == ""
+}
+
+// Render executes the template
+func (t *MessageTemplate) Render(data MessageTemplateData) (string, error) {
+	var sb strings.Builder
+	if err := t.tmpl.Execute(&sb, data); err != nil {
+		return "", fmt.Errorf("render template %s: %w", t.Name, err)
+	}
+	return sb.String(), nil
+}
+
+// LoadMessageTemplate returns the template with the highest precedence: the project
+// template, then the user template, then the built-in one
+func LoadMessageTemplate(name string) (*MessageTemplate, error) {
+	if !isTemplateName(name) {
+		return nil, fmt.Errorf("unknown template %q (available: %s)", name, strings.Join(TemplateNames, ", "))
+	}
+
+	for _, dir := range config.TemplateDirs() {
+		path := filepath.Join(dir, name+".tmpl")
+		text, err := os.ReadFile(path)
+		if errors.Is(err, fs.ErrNotExist) {
+			continue
+		}
+		if err != nil {
+			return nil, fmt.Errorf("read template: %w", err)
+		}
+		return ParseMessageTemplate(name, path, string(text))
+	}
+
+	return builtinMessageTemplate(name)
+}
+
+// ParseMessageTemplate parses template text with the message helper functions
+func ParseMessageTemplate(name, path, text string) (*MessageTemplate, error) {
+	tmpl, err := template.New(name).Funcs(templateFuncs()).Parse(text)
+	if err != nil {
+		return nil, fmt.Errorf("parse template %s: %w", name, err)
+	}
+	return &MessageTemplate{Name: name, Path: path, tmpl: tmpl}, nil
+}
+
+// BuiltinTemplateText returns the source of a built-in template, a starting point for customizing
+func BuiltinTemplateText(name string) (string, error) {
+	if !isTemplateName(name) {
+		return "", fmt.Errorf("unknown template %q (available: %s)", name, strings.Join(TemplateNames, ", "))
+	}
+
+	text, err := builtinTemplates.ReadFile("templates/" + name + ".tmpl")
+	if err != nil {
+		return "", fmt.Errorf("read built-in template: %w", err)
+	}
+	return string(text), nil
+}
+
+func builtinMessageTemplate(name string) (*MessageTemplate, error) {
+	text, err := BuiltinTemplateText(name)
+	if err != nil {
+		return nil, err
+	}
+	return ParseMessageTemplate(name, "", text)
+}
+
+// renderMessage renders a message with the active template, reporting whether a user
+// template was used. User templates replace the rich card layout with their plain text.
+func renderMessage(name string, data MessageTemplateData) (string, bool, error) {
+	tmpl, err := LoadMessageTemplate(name)
+	if err != nil {
+		return "", false, err
+	}
+
+	message, err := tmpl.Render(data)
+	if err != nil {
+		return "", false, err
+	}
+	return message, !tmpl.IsBuiltin(), nil
+}
+
+func isTemplateName(name string) bool {
+	for _, n := range TemplateNames {
+		if n == name {
+			return true
+		}
+	}
+	return false
+}
+
+// templateFuncs returns the helper functions available in message templates
+func templateFuncs() template.FuncMap {
+	return template.FuncMap{
+		// prNumber returns the PR/MR number of a GitHub or GitLab link, e.g. "42"
+		"prNumber": common.ExtractPRNumber,
+		// jiraKey returns the ticket key of a Jira link, e.g. "PROJ-123"
+		"jiraKey": common.ExtractJiraTicketNumber,
+		// priorityBadge returns e.g. "🔴 P0 · Critical"
+		"priorityBadge": PriorityBadge,
+		// requestType returns the label of a request type, e.g. "🚑 Hotfix"
+		"requestType": RequestTypeLabel,
+		// formatTime formats a time (or *time.Time), by default as "2006-01-02 15:04:05"
+		"formatTime": formatTemplateTime,
+		"join":       func(items []string, sep string) string { return strings.Join(items, sep) },
+		"upper":      strings.ToUpper,
+		"lower":      strings.ToLower,
+		"trim":       strings.TrimSpace,
+		// default returns def when value is empty: {{default "n/a" .Notes}}
+		"default": func(def, value string) string {
+			if value == "" {
+				return def
+			}
+			return value
+		},
+		// env reads a COOL_* environment variable, e.g. {{env "COOL_SQUAD"}}
+		"env": templateEnv,
+	}
+}
+
+// templateEnvPrefix limits the environment variables templates can read. Project templates
+// come from the checked-out repository, so they must not reach tokens like GITHUB_TOKEN.
+const templateEnvPrefix = "COOL_"
+
+func templateEnv(name string) (string, error) {
+	if !strings.HasPrefix(name, templateEnvPrefix) {
+		return "", fmt.Errorf("only %s* variables are available to templates, got %q", templateEnvPrefix, name)
+	}
+	return os.Getenv(name), nil
+}
+
+func formatTemplateTime(value interface{}, layout ...string) (string, error) {
+	format := "2006-01-02 15:04:05"
+	if len(layout) > 0 {
+		format = layout[0]
+	}
+
+	switch t := value.(type) {
+	case time.Time:
+		return t.Format(format), nil
+	case *time.Time:
+		if t == nil {
+			return "", nil
+		}
+		return t.Format(format), nil
+	default:
+		return "", fmt.Errorf("formatTime: expected a time, got %T", value)
+	}
+}
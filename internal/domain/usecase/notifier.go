@@ -0,0 +1,270 @@
+package usecase
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/yatbfi/cool/config"
+	"github.com/yatbfi/cool/internal/domain/entity"
+	"github.com/yatbfi/cool/internal/pkg/common"
+)
+
+// Notification kinds
+const (
+	NotificationReviewRequest = "review_request"
+	NotificationCollaboration = "collaboration"
+	NotificationAmendment     = "amendment"
+	NotificationWithdrawal    = "withdrawal"
+	NotificationReminder      = "reminder"
+)
+
+// NotificationField is a labelled detail shown in rich layouts
+type NotificationField = entity.NotificationField
+
+// Notification is a backend-neutral message about a review request
+type Notification struct {
+	Kind     string              `json:"kind"`
+	Headline string              `json:"headline"` // e.g. "🔍 New Review Request"
+	Text     string              `json:"text"`     // complete plain-text message, also used as fallback
+	Fields   []NotificationField `json:"fields,omitempty"`
+	Mentions []Mention           `json:"mentions,omitempty"` // reviewers called out, kept out of Text
+	Entry    *ReviewHistoryEntry `json:"entry"`
+
+	// Rich enables card/block layouts with description and link buttons
+	Rich bool `json:"-"`
+}
+
+// Delivery describes where a notification landed
+type Delivery struct {
+	Thread      *entity.ChatThread // nil for backends without thread support
+	MessageName string
+}
+
+// Notifier delivers notifications to one type of chat backend
+type Notifier interface {
+	// Notify sends a notification to a channel. Backends supporting threads reply in the
+	// given thread (nil starts a new one) and return it in the delivery.
+	Notify(ctx context.Context, channel config.Channel, n *Notification, thread *entity.ChatThread) (*Delivery, error)
+}
+
+// stageNotification is a notification addressed to every channel of a stage
+type stageNotification struct {
+	stage        string
+	notification *Notification
+}
+
+// publish persists the entry together with its queued notifications, then attempts delivery.
+// Failed sends stay in the outbox for retry instead of failing the operation, so history and
+// outbox always agree on what still has to be sent.
+func (u *reviewUsecase) publish(ctx context.Context, entry *ReviewHistoryEntry, save func(context.Context, *ReviewHistoryEntry) error, notifications ...stageNotification) error {
+	var queued []*OutboxMessage
+	for _, sn := range notifications {
+		messages, err := u.outbox.Enqueue(ctx, entry, sn.stage, sn.notification)
+		if err != nil {
+			u.discard(ctx, queued)
+			return err
+		}
+		queued = append(queued, messages...)
+	}
+
+	if err := save(ctx, entry); err != nil {
+		u.discard(ctx, queued)
+		return fmt.Errorf("save history: %w", err)
+	}
+
+	if err := u.outbox.Deliver(ctx, entry, queued); err != nil {
+		return err
+	}
+
+	// Remember the threads so follow-up messages reply in them
+	if err := u.historyRepo.Update(ctx, entry); err != nil {
+		return fmt.Errorf("update history: %w", err)
+	}
+	return nil
+}
+
+// discard removes queued messages whose review change could not be saved
+func (u *reviewUsecase) discard(ctx context.Context, messages []*OutboxMessage) {
+	for _, m := range messages {
+		_, _ = u.outbox.Drop(ctx, m.ID)
+	}
+}
+
+// DeliveryError is returned when a backend rejects a message with a non-2xx status
+type DeliveryError struct {
+	StatusCode int
+	RetryAfter time.Duration // from the Retry-After header, zero if absent
+}
+
+func (e *DeliveryError) Error() string {
+	return fmt.Sprintf("unexpected status code: %d", e.StatusCode)
+}
+
+// Retryable reports whether sending the same message again may succeed
+func (e *DeliveryError) Retryable() bool {
+	return e.StatusCode == http.StatusRequestTimeout ||
+		e.StatusCode == http.StatusTooManyRequests ||
+		e.StatusCode >= 500
+}
+
+// newDeliveryError builds a DeliveryError from a rejected response
+func newDeliveryError(resp *http.Response) *DeliveryError {
+	return &DeliveryError{
+		StatusCode: resp.StatusCode,
+		RetryAfter: parseRetryAfter(resp.Header.Get("Retry-After"), time.Now()),
+	}
+}
+
+// parseRetryAfter reads a Retry-After header given either in seconds or as an HTTP date
+func parseRetryAfter(value string, now time.Time) time.Duration {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return 0
+	}
+	if seconds, err := strconv.Atoi(value); err == nil && seconds > 0 {
+		return time.Duration(seconds) * time.Second
+	}
+	if at, err := http.ParseTime(value); err == nil && at.After(now) {
+		return at.Sub(now)
+	}
+	return 0
+}
+
+// hasStageChannels reports whether a stage of a review request has at least one usable channel
+func hasStageChannels(entry *ReviewHistoryEntry, stage string) bool {
+	_, err := RouteChannels(entry, stage)
+	return err == nil
+}
+
+// postJSON posts a JSON body and returns the response body, accepting any 2xx status
+func postJSON(ctx context.Context, client *http.Client, url string, body []byte, headers map[string]string) ([]byte, error) {
+	req, err := http.NewRequestWithContext(ctx, "POST", url, bytes.NewReader(body))
+	if err != nil {
+		return nil, fmt.Errorf("create request: %w", err)
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+	for key, value := range headers {
+		req.Header.Set(key, value)
+	}
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("send request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	respBody, _ := io.ReadAll(resp.Body)
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return nil, newDeliveryError(resp)
+	}
+	return respBody, nil
+}
+
+// marshalPayload encodes a payload without escaping HTML characters in message text
+func marshalPayload(payload interface{}) ([]byte, error) {
+	var buf bytes.Buffer
+	encoder := json.NewEncoder(&buf)
+	encoder.SetEscapeHTML(false)
+	if err := encoder.Encode(payload); err != nil {
+		return nil, fmt.Errorf("marshal payload: %w", err)
+	}
+	return buf.Bytes(), nil
+}
+
+// prLinkLabel returns the button label for a pull request link
+func prLinkLabel(link string) string {
+	if number := common.ExtractPRNumber(link); number != "" {
+		return "PR #" + number
+	}
+	return "Open PR"
+}
+
+// jiraLinkLabel returns the button label for a Jira link
+func jiraLinkLabel(link string) string {
+	if ticket := common.ExtractJiraTicketNumber(link); ticket != "" {
+		return ticket
+	}
+	return "Open ticket"
+}
+
+// withLinkDetailsFields appends the fetched code host and Jira details of the entry's links, if any
+func withLinkDetailsFields(fields []NotificationField, entry *ReviewHistoryEntry) []NotificationField {
+	if len(entry.PullRequests) > 0 {
+		var lines []string
+		for _, pr := range entry.PullRequests {
+			lines = append(lines, fmt.Sprintf("%s#%d: %s", pr.Repository, pr.Number, pr.Summary()))
+		}
+		fields = append(fields, NotificationField{Label: "Pull requests", Value: strings.Join(lines, "\n")})
+	}
+
+	if len(entry.JiraIssues) > 0 {
+		var lines []string
+		for _, issue := range entry.JiraIssues {
+			lines = append(lines, fmt.Sprintf("%s: %s", issue.Key, issue.Details()))
+		}
+		fields = append(fields, NotificationField{Label: "Jira issues", Value: strings.Join(lines, "\n")})
+	}
+	return fields
+}
+
+// newReviewRequestNotification creates the notification for a new request. Rich layouts are
+// disabled when the message comes from a user template, so its text is what gets posted.
+func newReviewRequestNotification(entry *ReviewHistoryEntry, message string, rich bool) *Notification {
+	mentions := reviewerMentions(entry, config.StageReview)
+	return &Notification{
+		Kind:     NotificationReviewRequest,
+		Headline: "🔍 New Review Request",
+		Text:     message,
+		Fields: withReviewersField(withLinkDetailsFields(withTypeField([]NotificationField{
+			{Label: "Submitted by", Value: fmt.Sprintf("%s (%s)", entry.SubmittedBy, entry.SubmittedByEmail)},
+			{Label: "Submitted at", Value: entry.SubmittedAt.Format("2006-01-02 15:04:05")},
+			{Label: "Request ID", Value: entry.ID},
+		}, entry), entry), mentions),
+		Mentions: mentions,
+		Entry:    entry,
+		Rich:     rich,
+	}
+}
+
+// newCollaborationNotification creates the notification for a forward to collaboration
+func newCollaborationNotification(entry *ReviewHistoryEntry, message string, forwardedAt string, rich bool) *Notification {
+	approval := "✅ Approved"
+	if !entry.ApprovedByTechLead {
+		approval = "⚠️ Not yet (forwarded early)"
+	}
+
+	mentions := reviewerMentions(entry, config.StageCollab)
+	return &Notification{
+		Kind:     NotificationCollaboration,
+		Headline: "🚀 Review Request",
+		Text:     message,
+		Fields: withReviewersField(withLinkDetailsFields(withTypeField([]NotificationField{
+			{Label: "Originally submitted by", Value: fmt.Sprintf("%s (%s)", entry.SubmittedBy, entry.SubmittedByEmail)},
+			{Label: "Tech lead", Value: approval},
+			{Label: "Forwarded at", Value: forwardedAt},
+			{Label: "Request ID", Value: entry.ID},
+		}, entry), entry), mentions),
+		Mentions: mentions,
+		Entry:    entry,
+		Rich:     rich,
+	}
+}
+
+// newTextNotification creates a plain-text only notification headed by the first line of the message
+func newTextNotification(kind string, entry *ReviewHistoryEntry, message string) *Notification {
+	headline, _, _ := strings.Cut(message, "\n")
+	return &Notification{
+		Kind:     kind,
+		Headline: strings.ReplaceAll(headline, "*", ""),
+		Text:     message,
+		Entry:    entry,
+	}
+}
@@ -0,0 +1,73 @@
+package usecase
+
+import (
+	"context"
+	"strings"
+
+	"github.com/yatbfi/cool/config"
+	"github.com/yatbfi/cool/internal/domain/entity"
+)
+
+// gchatNotifier delivers notifications to Google Chat webhooks, keeping one thread per request
+type gchatNotifier struct {
+	gchatUc GChat
+}
+
+// NewGChatNotifier creates a notifier for Google Chat channels
+func NewGChatNotifier(gchatUc GChat) Notifier {
+	return &gchatNotifier{
+		gchatUc: gchatUc,
+	}
+}
+
+// Notify sends the notification as a Cards v2 card (or plain text) in the request thread
+func (n *gchatNotifier) Notify(ctx context.Context, channel config.Channel, notification *Notification, thread *entity.ChatThread) (*Delivery, error) {
+	current := entity.ChatThread{Key: threadKey(notification.Entry.ID)}
+	if thread != nil {
+		current = *thread
+	}
+
+	// Mentions only notify people when they are part of the message text
+	mentions := formatGChatMentions(notification.Mentions)
+	payload := &GChatPayload{Text: joinNonEmpty("\n", mentions, notification.Text)}
+	if notification.Rich && !channel.PlainText() {
+		payload = &GChatPayload{
+			Text:    joinNonEmpty(" ", notification.Headline, mentions),
+			CardsV2: []GChatCardV2{*buildReviewCard(notification)},
+		}
+	}
+
+	created, err := n.gchatUc.SendThreadMessage(ctx, channel.WebhookURL, payload, GChatThread{
+		Key:  current.Key,
+		Name: current.Name,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if current.Name == "" {
+		current.Name = created.ThreadName
+	}
+	if current.MessageName == "" {
+		current.MessageName = created.Name
+	}
+
+	return &Delivery{Thread: &current, MessageName: created.Name}, nil
+}
+
+// threadKey returns the deterministic thread key for a request, so follow-ups
+// land in the same thread even when the webhook response was not recorded
+func threadKey(entryID string) string {
+	return "cool-review-" + entryID
+}
+
+// joinNonEmpty joins the non-empty values with sep
+func joinNonEmpty(sep string, values ...string) string {
+	var parts []string
+	for _, value := range values {
+		if value != "" {
+			parts = append(parts, value)
+		}
+	}
+	return strings.Join(parts, sep)
+}
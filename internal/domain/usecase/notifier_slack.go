@@ -0,0 +1,120 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/yatbfi/cool/config"
+	"github.com/yatbfi/cool/internal/domain/entity"
+)
+
+// Maximum lengths of Block Kit texts; longer texts are rejected with invalid_blocks
+const (
+	slackTextLimit   = 3000 // section text
+	slackHeaderLimit = 150  // header text
+)
+
+// slackNotifier delivers notifications to Slack incoming webhooks using Block Kit
+type slackNotifier struct {
+	httpClient *http.Client
+}
+
+// NewSlackNotifier creates a notifier for Slack channels
+func NewSlackNotifier() Notifier {
+	return &slackNotifier{
+		httpClient: &http.Client{},
+	}
+}
+
+// Notify posts the notification; incoming webhooks do not support threads
+func (n *slackNotifier) Notify(ctx context.Context, channel config.Channel, notification *Notification, _ *entity.ChatThread) (*Delivery, error) {
+	if channel.WebhookURL == "" {
+		return nil, fmt.Errorf("webhook URL is empty")
+	}
+
+	payload := map[string]interface{}{
+		"text": notification.Text,
+	}
+	if notification.Rich && !channel.PlainText() {
+		payload["text"] = notification.Headline
+		payload["blocks"] = buildSlackBlocks(notification)
+	}
+
+	body, err := marshalPayload(payload)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := postJSON(ctx, n.httpClient, channel.WebhookURL, body, nil); err != nil {
+		return nil, err
+	}
+
+	return &Delivery{}, nil
+}
+
+// buildSlackBlocks renders a rich notification as Block Kit blocks
+func buildSlackBlocks(n *Notification) []map[string]interface{} {
+	entry := n.Entry
+
+	blocks := []map[string]interface{}{
+		{
+			"type": "header",
+			"text": map[string]interface{}{"type": "plain_text", "text": truncateText(entry.Title, slackHeaderLimit), "emoji": true},
+		},
+		{
+			"type": "context",
+			"elements": []map[string]interface{}{
+				{"type": "mrkdwn", "text": fmt.Sprintf("%s · *%s*", n.Headline, PriorityBadge(entry.Priority))},
+			},
+		},
+	}
+
+	if len(n.Fields) > 0 {
+		var fields []map[string]interface{}
+		for _, field := range n.Fields {
+			fields = append(fields, map[string]interface{}{
+				"type": "mrkdwn",
+				"text": fmt.Sprintf("*%s*\n%s", field.Label, field.Value),
+			})
+		}
+		blocks = append(blocks, map[string]interface{}{"type": "section", "fields": fields})
+	}
+
+	if entry.Description != "" {
+		blocks = append(blocks, map[string]interface{}{
+			"type": "section",
+			"text": map[string]interface{}{"type": "mrkdwn", "text": truncateText(entry.Description, slackTextLimit)},
+		})
+	}
+
+	var buttons []map[string]interface{}
+	for _, link := range entry.ReviewLinks {
+		buttons = append(buttons, slackButton(prLinkLabel(link), link))
+	}
+	for _, link := range entry.JiraLinks {
+		buttons = append(buttons, slackButton(jiraLinkLabel(link), link))
+	}
+	if len(buttons) > 0 {
+		blocks = append(blocks, map[string]interface{}{"type": "actions", "elements": buttons})
+	}
+
+	return blocks
+}
+
+func slackButton(label, link string) map[string]interface{} {
+	return map[string]interface{}{
+		"type": "button",
+		"text": map[string]interface{}{"type": "plain_text", "text": label},
+		"url":  link,
+	}
+}
+
+// truncateText shortens text to at most limit runes, marking the cut with an ellipsis
+func truncateText(text string, limit int) string {
+	runes := []rune(text)
+	if len(runes) <= limit {
+		return text
+	}
+	return strings.TrimSpace(string(runes[:limit-1])) + "…"
+}
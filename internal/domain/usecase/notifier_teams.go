@@ -0,0 +1,97 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/yatbfi/cool/config"
+	"github.com/yatbfi/cool/internal/domain/entity"
+)
+
+// teamsNotifier delivers notifications to Microsoft Teams webhooks as Adaptive Cards
+type teamsNotifier struct {
+	httpClient *http.Client
+}
+
+// NewTeamsNotifier creates a notifier for Microsoft Teams channels
+func NewTeamsNotifier() Notifier {
+	return &teamsNotifier{
+		httpClient: &http.Client{},
+	}
+}
+
+// Notify posts the notification as an Adaptive Card; Teams webhooks do not support threads
+func (n *teamsNotifier) Notify(ctx context.Context, channel config.Channel, notification *Notification, _ *entity.ChatThread) (*Delivery, error) {
+	if channel.WebhookURL == "" {
+		return nil, fmt.Errorf("webhook URL is empty")
+	}
+
+	card := buildTeamsCard(notification, notification.Rich && !channel.PlainText())
+	payload := map[string]interface{}{
+		"type": "message",
+		"attachments": []map[string]interface{}{
+			{
+				"contentType": "application/vnd.microsoft.card.adaptive",
+				"content":     card,
+			},
+		},
+	}
+
+	body, err := marshalPayload(payload)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := postJSON(ctx, n.httpClient, channel.WebhookURL, body, nil); err != nil {
+		return nil, err
+	}
+
+	return &Delivery{}, nil
+}
+
+// buildTeamsCard renders a notification as an Adaptive Card; plain notifications become a single text block
+func buildTeamsCard(n *Notification, rich bool) map[string]interface{} {
+	card := map[string]interface{}{
+		"$schema": "http://adaptivecards.io/schemas/adaptive-card.json",
+		"type":    "AdaptiveCard",
+		"version": "1.4",
+	}
+
+	if !rich {
+		card["body"] = []map[string]interface{}{
+			{"type": "TextBlock", "text": n.Text, "wrap": true},
+		}
+		return card
+	}
+
+	entry := n.Entry
+	facts := []map[string]interface{}{
+		{"title": "Priority", "value": PriorityBadge(entry.Priority)},
+	}
+	for _, field := range n.Fields {
+		facts = append(facts, map[string]interface{}{"title": field.Label, "value": field.Value})
+	}
+
+	body := []map[string]interface{}{
+		{"type": "TextBlock", "text": n.Headline, "weight": "Bolder", "isSubtle": true},
+		{"type": "TextBlock", "text": entry.Title, "size": "Large", "weight": "Bolder", "wrap": true},
+		{"type": "FactSet", "facts": facts},
+	}
+	if entry.Description != "" {
+		body = append(body, map[string]interface{}{"type": "TextBlock", "text": entry.Description, "wrap": true})
+	}
+	card["body"] = body
+
+	var actions []map[string]interface{}
+	for _, link := range entry.ReviewLinks {
+		actions = append(actions, map[string]interface{}{"type": "Action.OpenUrl", "title": prLinkLabel(link), "url": link})
+	}
+	for _, link := range entry.JiraLinks {
+		actions = append(actions, map[string]interface{}{"type": "Action.OpenUrl", "title": jiraLinkLabel(link), "url": link})
+	}
+	if len(actions) > 0 {
+		card["actions"] = actions
+	}
+
+	return card
+}
@@ -0,0 +1,73 @@
+package usecase
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"text/template"
+
+	"github.com/yatbfi/cool/config"
+	"github.com/yatbfi/cool/internal/domain/entity"
+)
+
+// defaultWebhookTemplate sends the whole notification when a channel has no template
+const defaultWebhookTemplate = `{"kind": {{json .Kind}}, "headline": {{json .Headline}}, "text": {{json .Text}}, "entry": {{json .Entry}}}`
+
+// webhookNotifier delivers notifications to generic JSON webhooks rendered from a template
+type webhookNotifier struct {
+	httpClient *http.Client
+}
+
+// NewWebhookNotifier creates a notifier for generic JSON webhook channels.
+// The channel template is a Go text/template executed with the Notification;
+// the "json" function encodes any value as JSON.
+func NewWebhookNotifier() Notifier {
+	return &webhookNotifier{
+		httpClient: &http.Client{},
+	}
+}
+
+// Notify renders the channel template and posts the resulting JSON document
+func (n *webhookNotifier) Notify(ctx context.Context, channel config.Channel, notification *Notification, _ *entity.ChatThread) (*Delivery, error) {
+	if channel.WebhookURL == "" {
+		return nil, fmt.Errorf("webhook URL is empty")
+	}
+
+	body, err := renderWebhookBody(channel.Template, notification)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := postJSON(ctx, n.httpClient, channel.WebhookURL, body, channel.Headers); err != nil {
+		return nil, err
+	}
+
+	return &Delivery{}, nil
+}
+
+// renderWebhookBody executes a webhook template and checks that it produced valid JSON
+func renderWebhookBody(text string, notification *Notification) ([]byte, error) {
+	if text == "" {
+		text = defaultWebhookTemplate
+	}
+
+	tmpl, err := template.New("webhook").Funcs(template.FuncMap{
+		"json": func(v interface{}) (string, error) {
+			data, err := marshalPayload(v)
+			return string(bytes.TrimSpace(data)), err
+		},
+	}).Parse(text)
+	if err != nil {
+		return nil, fmt.Errorf("parse webhook template: %w", err)
+	}
+
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, notification); err != nil {
+		return nil, fmt.Errorf("render webhook template: %w", err)
+	}
+	if !json.Valid(buf.Bytes()) {
+		return nil, fmt.Errorf("webhook template did not produce valid JSON")
+	}
+	return buf.Bytes(), nil
+}
@@ -0,0 +1,342 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/yatbfi/cool/config"
+	"github.com/yatbfi/cool/internal/domain/entity"
+	"github.com/yatbfi/cool/internal/domain/repository"
+)
+
+// Outbox retry policy
+const (
+	outboxMaxAttempts = 8
+	outboxBaseDelay   = 30 * time.Second
+	outboxMaxDelay    = time.Hour
+	outboxRetention   = 7 * 24 * time.Hour // delivered messages are pruned after this
+)
+
+// OutboxMessage is a type alias for entity.OutboxMessage
+type OutboxMessage = entity.OutboxMessage
+
+// Outbox queues notifications persistently so a failed send can be retried later
+type Outbox interface {
+	// Enqueue persists one pending message per channel of a stage
+	Enqueue(ctx context.Context, entry *ReviewHistoryEntry, stage string, n *Notification) ([]*OutboxMessage, error)
+
+	// Deliver attempts to send queued messages of a review request. The outcome is recorded
+	// on each message and resulting threads on the entry, which the caller persists.
+	// The returned error only reports persistence failures, not failed sends.
+	Deliver(ctx context.Context, entry *ReviewHistoryEntry, messages []*OutboxMessage) error
+
+	// List returns queued messages, optionally including delivered ones
+	List(ctx context.Context, includeSent bool) ([]*OutboxMessage, error)
+
+	// Undelivered returns the pending and failed messages of a review request
+	Undelivered(ctx context.Context, entryID string) ([]*OutboxMessage, error)
+
+	// Flush retries pending messages that are due, or every undelivered message when all is set,
+	// and returns the attempted messages
+	Flush(ctx context.Context, all bool) ([]*OutboxMessage, error)
+
+	// Drop removes a message from the outbox without sending it
+	Drop(ctx context.Context, id string) (*OutboxMessage, error)
+}
+
+// outboxUsecase implements Outbox interface
+type outboxUsecase struct {
+	outboxRepo  repository.OutboxRepository
+	historyRepo repository.ReviewHistoryRepository
+	notifiers   map[string]Notifier
+	now         func() time.Time
+}
+
+// NewOutboxUsecase creates a new outbox usecase. Notifiers are keyed by channel type
+// (see config.ChannelTypes).
+func NewOutboxUsecase(outboxRepo repository.OutboxRepository, historyRepo repository.ReviewHistoryRepository, notifiers map[string]Notifier) Outbox {
+	return &outboxUsecase{
+		outboxRepo:  outboxRepo,
+		historyRepo: historyRepo,
+		notifiers:   notifiers,
+		now:         time.Now,
+	}
+}
+
+// Enqueue persists one pending message per channel of a stage, as routed for the entry
+func (u *outboxUsecase) Enqueue(ctx context.Context, entry *ReviewHistoryEntry, stage string, n *Notification) ([]*OutboxMessage, error) {
+	channels, err := RouteChannels(entry, stage)
+	if err != nil {
+		return nil, err
+	}
+
+	now := u.now()
+	messages := make([]*OutboxMessage, 0, len(channels))
+	for _, channel := range channels {
+		id, err := generateID()
+		if err != nil {
+			return nil, fmt.Errorf("generate ID: %w", err)
+		}
+
+		messages = append(messages, &entity.OutboxMessage{
+			ID:         id,
+			EntryID:    entry.ID,
+			Channel:    channel.Name,
+			Kind:       n.Kind,
+			Headline:   n.Headline,
+			Text:       n.Text,
+			Fields:     n.Fields,
+			Mentions:   n.Mentions,
+			Rich:       n.Rich,
+			EventIndex: len(entry.Events) - 1,
+			Status:     entity.OutboxStatusPending,
+			CreatedAt:  now,
+		})
+	}
+
+	if err := u.outboxRepo.Save(ctx, messages...); err != nil {
+		return nil, fmt.Errorf("queue notification: %w", err)
+	}
+	return messages, nil
+}
+
+// Deliver attempts to send queued messages of a review request
+func (u *outboxUsecase) Deliver(ctx context.Context, entry *ReviewHistoryEntry, messages []*OutboxMessage) error {
+	queued, err := u.outboxRepo.FindAll(ctx)
+	if err != nil {
+		return fmt.Errorf("get outbox: %w", err)
+	}
+
+	// Messages of one request to one channel go out in order, so a follow-up never
+	// overtakes the message that starts the thread
+	batch := make(map[string]bool, len(messages))
+	for _, m := range messages {
+		batch[m.ID] = true
+	}
+	blocked := map[string]bool{}
+	for _, m := range queued {
+		if m.EntryID == entry.ID && m.Status == entity.OutboxStatusPending && !batch[m.ID] {
+			blocked[m.Channel] = true
+		}
+	}
+
+	for _, m := range messages {
+		if blocked[m.Channel] {
+			m.LastError = "waiting for an earlier message to the same channel"
+		} else {
+			u.attempt(ctx, entry, m)
+		}
+
+		if err := u.outboxRepo.Update(ctx, m); err != nil {
+			return fmt.Errorf("update outbox: %w", err)
+		}
+		if !m.IsDelivered() {
+			blocked[m.Channel] = true
+		}
+	}
+	return nil
+}
+
+// List returns queued messages, optionally including delivered ones
+func (u *outboxUsecase) List(ctx context.Context, includeSent bool) ([]*OutboxMessage, error) {
+	messages, err := u.outboxRepo.FindAll(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("get outbox: %w", err)
+	}
+	if includeSent {
+		return messages, nil
+	}
+
+	var undelivered []*OutboxMessage
+	for _, m := range messages {
+		if !m.IsDelivered() {
+			undelivered = append(undelivered, m)
+		}
+	}
+	return undelivered, nil
+}
+
+// Undelivered returns the pending and failed messages of a review request
+func (u *outboxUsecase) Undelivered(ctx context.Context, entryID string) ([]*OutboxMessage, error) {
+	messages, err := u.List(ctx, false)
+	if err != nil {
+		return nil, err
+	}
+
+	var filtered []*OutboxMessage
+	for _, m := range messages {
+		if m.EntryID == entryID {
+			filtered = append(filtered, m)
+		}
+	}
+	return filtered, nil
+}
+
+// Flush retries pending messages that are due, or every undelivered message when all is set
+func (u *outboxUsecase) Flush(ctx context.Context, all bool) ([]*OutboxMessage, error) {
+	messages, err := u.outboxRepo.FindAll(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("get outbox: %w", err)
+	}
+
+	now := u.now()
+	entries := map[string]*ReviewHistoryEntry{}
+	touched := map[string]bool{}
+	blocked := map[string]bool{}
+	var attempted []*OutboxMessage
+
+	for _, m := range messages {
+		if m.IsDelivered() {
+			if m.SentAt != nil && now.Sub(*m.SentAt) > outboxRetention {
+				if err := u.outboxRepo.Delete(ctx, m.ID); err != nil {
+					return attempted, fmt.Errorf("prune outbox: %w", err)
+				}
+			}
+			continue
+		}
+
+		key := m.EntryID + "/" + m.Channel
+		if blocked[key] || !(all || m.IsDue(now)) {
+			if m.Status == entity.OutboxStatusPending {
+				blocked[key] = true
+			}
+			continue
+		}
+
+		entry, ok := entries[m.EntryID]
+		if !ok {
+			// A missing entry is recorded as nil so its other messages fail the same way
+			entry, _ = u.historyRepo.FindByID(ctx, m.EntryID)
+			entries[m.EntryID] = entry
+		}
+
+		if entry == nil {
+			m.Status = entity.OutboxStatusFailed
+			m.LastError = "review request no longer exists"
+			m.NextAttemptAt = nil
+		} else {
+			u.attempt(ctx, entry, m)
+		}
+
+		if err := u.outboxRepo.Update(ctx, m); err != nil {
+			return attempted, fmt.Errorf("update outbox: %w", err)
+		}
+		attempted = append(attempted, m)
+
+		if m.IsDelivered() {
+			touched[m.EntryID] = true
+		} else {
+			blocked[key] = true
+		}
+	}
+
+	// Remember new threads so later messages reply in them
+	for id := range touched {
+		if err := u.historyRepo.Update(ctx, entries[id]); err != nil {
+			return attempted, fmt.Errorf("update history: %w", err)
+		}
+	}
+
+	return attempted, nil
+}
+
+// Drop removes a message from the outbox without sending it
+func (u *outboxUsecase) Drop(ctx context.Context, id string) (*OutboxMessage, error) {
+	m, err := u.outboxRepo.FindByID(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("get outbox message: %w", err)
+	}
+
+	if err := u.outboxRepo.Delete(ctx, id); err != nil {
+		return nil, fmt.Errorf("delete outbox message: %w", err)
+	}
+	return m, nil
+}
+
+// attempt sends a message once and records the outcome on the message and entry
+func (u *outboxUsecase) attempt(ctx context.Context, entry *ReviewHistoryEntry, m *OutboxMessage) {
+	now := u.now()
+	m.Attempts++
+	m.LastAttemptAt = &now
+
+	delivery, err := u.send(ctx, entry, m)
+	if err != nil {
+		m.LastError = err.Error()
+		if m.Attempts >= outboxMaxAttempts || !isRetryable(err) {
+			m.Status = entity.OutboxStatusFailed
+			m.NextAttemptAt = nil
+			return
+		}
+
+		next := now.Add(retryDelay(m.Attempts, err))
+		m.Status = entity.OutboxStatusPending
+		m.NextAttemptAt = &next
+		return
+	}
+
+	m.Status = entity.OutboxStatusSent
+	m.SentAt = &now
+	m.NextAttemptAt = nil
+	m.LastError = ""
+
+	if delivery.Thread != nil {
+		if entry.Threads == nil {
+			entry.Threads = map[string]*entity.ChatThread{}
+		}
+		entry.Threads[m.Channel] = delivery.Thread
+	}
+	if m.EventIndex >= 0 && m.EventIndex < len(entry.Events) && entry.Events[m.EventIndex].MessageName == "" {
+		entry.Events[m.EventIndex].MessageName = delivery.MessageName
+	}
+}
+
+// send resolves the message channel from the current config and hands it to its notifier
+func (u *outboxUsecase) send(ctx context.Context, entry *ReviewHistoryEntry, m *OutboxMessage) (*Delivery, error) {
+	channel, ok := config.GetConfig().FindChannel(m.Channel)
+	if !ok {
+		return nil, fmt.Errorf("channel %q is not configured", m.Channel)
+	}
+
+	notifier, ok := u.notifiers[channel.Type]
+	if !ok {
+		return nil, fmt.Errorf("unsupported channel type %q", channel.Type)
+	}
+
+	return notifier.Notify(ctx, channel, &Notification{
+		Kind:     m.Kind,
+		Headline: m.Headline,
+		Text:     m.Text,
+		Fields:   m.Fields,
+		Mentions: m.Mentions,
+		Entry:    entry,
+		Rich:     m.Rich,
+	}, entry.Threads[channel.Name])
+}
+
+// isRetryable reports whether a failed send is worth repeating. Backends rejecting the
+// message itself (4xx other than timeouts and rate limits) will keep rejecting it.
+func isRetryable(err error) bool {
+	var deliveryErr *DeliveryError
+	if errors.As(err, &deliveryErr) {
+		return deliveryErr.Retryable()
+	}
+	return true
+}
+
+// retryDelay returns the exponential backoff after the given number of attempts,
+// honouring a longer Retry-After requested by the backend
+func retryDelay(attempts int, err error) time.Duration {
+	delay := outboxBaseDelay
+	for i := 1; i < attempts && delay < outboxMaxDelay; i++ {
+		delay *= 2
+	}
+	delay = min(delay, outboxMaxDelay)
+
+	var deliveryErr *DeliveryError
+	if errors.As(err, &deliveryErr) && deliveryErr.RetryAfter > delay {
+		return deliveryErr.RetryAfter
+	}
+	return delay
+}
@@ -0,0 +1,271 @@
+package usecase
+
+import (
+	"embed"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/yatbfi/cool/config"
+)
+
+// Request types
+const (
+	RequestTypeFeature   = "feature"
+	RequestTypeBugfix    = "bugfix"
+	RequestTypeHotfix    = "hotfix"
+	RequestTypeRefactor  = "refactor"
+	RequestTypeMigration = "migration"
+)
+
+// RequestTypes lists the supported request types
+var RequestTypes = []string{RequestTypeFeature, RequestTypeBugfix, RequestTypeHotfix, RequestTypeRefactor, RequestTypeMigration}
+
+var requestTypeLabels = map[string]string{
+	RequestTypeFeature:   "✨ Feature",
+	RequestTypeBugfix:    "🐛 Bugfix",
+	RequestTypeHotfix:    "🚑 Hotfix",
+	RequestTypeRefactor:  "♻️ Refactor",
+	RequestTypeMigration: "🗃️ Migration",
+}
+
+// RequestTypeLabel returns the display label of a request type, e.g. "🚑 Hotfix"
+func RequestTypeLabel(requestType string) string {
+	if label, ok := requestTypeLabels[requestType]; ok {
+		return label
+	}
+	return requestType
+}
+
+// NormalizeRequestType lower-cases and trims a request type value (e.g. " Hotfix" -> "hotfix")
+func NormalizeRequestType(requestType string) string {
+	return strings.ToLower(strings.TrimSpace(requestType))
+}
+
+//go:embed templates/*.md
+var builtinDescriptionTemplates embed.FS
+
+// descriptionCommentPrefix starts the hint lines of description templates, which are
+// removed from the description like the comment lines of the editor prompt
+const descriptionCommentPrefix = ";;"
+
+var (
+	// headingPattern matches a Markdown heading and captures its text
+	headingPattern = regexp.MustCompile(`^#{1,6}\s+(.+?)\s*#*\s*$`)
+	// requiredPattern matches the hint line marking a template section as required
+	requiredPattern = regexp.MustCompile(`(?i)^;;\s*required\b`)
+)
+
+// DescriptionTemplate is the Markdown description template of a request type. User
+// templates are looked up as <type>.md in the project and user template directories
+// (see config.TemplateDirs).
+type DescriptionTemplate struct {
+	Type string
+	Path string // empty for the built-in template
+	Text string
+
+	sections []descriptionSection
+}
+
+// descriptionSection is a heading of a description and the text below it
+type descriptionSection struct {
+	Heading  string
+	Body     string
+	Required bool
+	Text     string // the heading, hint and body lines as written
+}
+
+// RequiredSections returns the headings of the sections that must be filled in
+func (t *DescriptionTemplate) RequiredSections() []string {
+	var headings []string
+	for _, s := range t.sections {
+		if s.Required {
+			headings = append(headings, s.Heading)
+		}
+	}
+	return headings
+}
+
+// MissingSections returns the required sections that are absent from a description,
+// empty, or still hold only the template's placeholder text
+func (t *DescriptionTemplate) MissingSections(description string) []string {
+	written := parseDescriptionSections(description)
+
+	var missing []string
+	for _, required := range t.sections {
+		if !required.Required {
+			continue
+		}
+		i := slices.IndexFunc(written, func(s descriptionSection) bool {
+			return strings.EqualFold(s.Heading, required.Heading)
+		})
+		if i < 0 || written[i].Body == "" || written[i].Body == required.Body {
+			missing = append(missing, required.Heading)
+		}
+	}
+	return missing
+}
+
+// Scaffold returns the text to open in the editor for a description: the whole template
+// for an empty description, otherwise the description followed by the template sections
+// it does not have yet
+func (t *DescriptionTemplate) Scaffold(description string) string {
+	description = strings.TrimSpace(description)
+	if description == "" {
+		return t.Text
+	}
+
+	written := parseDescriptionSections(description)
+	var sb strings.Builder
+	sb.WriteString(description)
+	for _, s := range t.sections {
+		if slices.ContainsFunc(written, func(w descriptionSection) bool { return strings.EqualFold(w.Heading, s.Heading) }) {
+			continue
+		}
+		sb.WriteString("\n\n")
+		sb.WriteString(strings.TrimSpace(s.Text))
+	}
+	sb.WriteString("\n")
+	return sb.String()
+}
+
+// LoadDescriptionTemplate returns the description template of a request type with the
+// highest precedence: the project template, then the user template, then the built-in one
+func LoadDescriptionTemplate(requestType string) (*DescriptionTemplate, error) {
+	if !slices.Contains(RequestTypes, requestType) {
+		return nil, fmt.Errorf("unknown request type %q (available: %s)", requestType, strings.Join(RequestTypes, ", "))
+	}
+
+	for _, dir := range config.TemplateDirs() {
+		path := filepath.Join(dir, requestType+".md")
+		text, err := os.ReadFile(path)
+		if errors.Is(err, fs.ErrNotExist) {
+			continue
+		}
+		if err != nil {
+			return nil, fmt.Errorf("read description template: %w", err)
+		}
+		return newDescriptionTemplate(requestType, path, string(text)), nil
+	}
+
+	text, err := builtinDescriptionTemplates.ReadFile("templates/" + requestType + ".md")
+	if err != nil {
+		return nil, fmt.Errorf("read built-in description template: %w", err)
+	}
+	return newDescriptionTemplate(requestType, "", string(text)), nil
+}
+
+func newDescriptionTemplate(requestType, path, text string) *DescriptionTemplate {
+	return &DescriptionTemplate{
+		Type:     requestType,
+		Path:     path,
+		Text:     text,
+		sections: parseDescriptionSections(text),
+	}
+}
+
+// withTypeField puts the request type in front of the notification fields of a typed request
+func withTypeField(fields []NotificationField, entry *ReviewHistoryEntry) []NotificationField {
+	if entry.Type == "" {
+		return fields
+	}
+	return append([]NotificationField{{Label: "Type", Value: RequestTypeLabel(entry.Type)}}, fields...)
+}
+
+// MissingSectionsError is returned when a typed request lacks required description sections
+type MissingSectionsError struct {
+	Type     string
+	Sections []string
+}
+
+func (e *MissingSectionsError) Error() string {
+	return fmt.Sprintf("%s requests need these description sections filled in: %s",
+		e.Type, strings.Join(quoteAll(e.Sections), ", "))
+}
+
+// MissingSections returns the required sections of the request type's template that the
+// description does not fill in. Untyped requests have no required sections.
+func (r *ReviewRequest) MissingSections() ([]string, error) {
+	if r.Type == "" {
+		return nil, nil
+	}
+
+	tmpl, err := LoadDescriptionTemplate(r.Type)
+	if err != nil {
+		return nil, err
+	}
+	return tmpl.MissingSections(StripDescriptionComments(r.Description)), nil
+}
+
+// validateSections checks that the description of a typed request fills in every
+// required section of its template
+func validateSections(req *ReviewRequest) error {
+	missing, err := req.MissingSections()
+	if err != nil {
+		return err
+	}
+	if len(missing) > 0 {
+		return &MissingSectionsError{Type: req.Type, Sections: missing}
+	}
+	return nil
+}
+
+// StripDescriptionComments removes the ";;" hint lines left over from a description template
+func StripDescriptionComments(description string) string {
+	lines := strings.Split(description, "\n")
+	kept := lines[:0]
+	for _, line := range lines {
+		if !strings.HasPrefix(strings.TrimSpace(line), descriptionCommentPrefix) {
+			kept = append(kept, line)
+		}
+	}
+	return strings.TrimSpace(strings.Join(kept, "\n"))
+}
+
+// parseDescriptionSections splits Markdown text at its headings. Hint lines are left out
+// of the section bodies; a "required" hint marks the section as required.
+func parseDescriptionSections(text string) []descriptionSection {
+	var sections []descriptionSection
+	var body, raw []string
+	flush := func() {
+		if len(sections) > 0 {
+			sections[len(sections)-1].Body = strings.TrimSpace(strings.Join(body, "\n"))
+			sections[len(sections)-1].Text = strings.Join(raw, "\n")
+		}
+		body, raw = nil, nil
+	}
+
+	for _, line := range strings.Split(text, "\n") {
+		trimmed := strings.TrimSpace(line)
+		if m := headingPattern.FindStringSubmatch(trimmed); m != nil {
+			flush()
+			sections = append(sections, descriptionSection{Heading: m[1]})
+			raw = append(raw, line)
+			continue
+		}
+		raw = append(raw, line)
+		if strings.HasPrefix(trimmed, descriptionCommentPrefix) {
+			if len(sections) > 0 && requiredPattern.MatchString(trimmed) {
+				sections[len(sections)-1].Required = true
+			}
+			continue
+		}
+		body = append(body, line)
+	}
+	flush()
+
+	return sections
+}
+
+func quoteAll(values []string) []string {
+	quoted := make([]string, 0, len(values))
+	for _, v := range values {
+		quoted = append(quoted, fmt.Sprintf("%q", v))
+	}
+	return quoted
+}
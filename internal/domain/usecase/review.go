@@ -5,6 +5,9 @@ import (
 	"crypto/rand"
 	"encoding/hex"
 	"fmt"
+	"io"
+	"slices"
+	"strings"
 	"time"
 
 	"github.com/yatbfi/cool/config"
@@ -14,11 +17,44 @@ import (
 
 // ReviewRequest represents a review request input
 type ReviewRequest struct {
-	Title       string
-	Description string
-	Priority    string
-	ReviewLinks []string
-	JiraLinks   []string
+	Title       string `json:"title" yaml:"title"`
+	Description string `json:"description" yaml:"description"`
+	Priority    string `json:"priority" yaml:"priority"`
+	// Type is the request type (see RequestTypes); typed requests get a description template
+	Type        string   `json:"type,omitempty" yaml:"type,omitempty"`
+	ReviewLinks []string `json:"review_links" yaml:"review_links"`
+	JiraLinks   []string `json:"jira_links" yaml:"jira_links"`
+	// ProjectDir is the directory under project_root the request is made from, used for routing
+	ProjectDir string `json:"project_dir,omitempty" yaml:"project_dir,omitempty"`
+}
+
+// Priorities lists the supported priority levels, from most to least urgent
+var Priorities = []string{"P0", "P1", "P2", "P3", "P4"}
+
+// DefaultPriority is used when a request does not specify a priority
+const DefaultPriority = "P2"
+
+// NormalizePriority upper-cases and trims a priority value (e.g. " p1" -> "P1")
+func NormalizePriority(priority string) string {
+	return strings.ToUpper(strings.TrimSpace(priority))
+}
+
+// Validate checks that the request contains everything required for submission,
+// removes template hints from the description and normalizes its links (see NormalizeLinks).
+// Required description sections are only checked when sending (see validateSections).
+func (r *ReviewRequest) Validate() error {
+	if strings.TrimSpace(r.Title) == "" {
+		return fmt.Errorf("title is required")
+	}
+	if !slices.Contains(Priorities, r.Priority) {
+		return fmt.Errorf("invalid priority %q (must be one of %s)", r.Priority, strings.Join(Priorities, ", "))
+	}
+	r.Type = NormalizeRequestType(r.Type)
+	if r.Type != "" && !slices.Contains(RequestTypes, r.Type) {
+		return fmt.Errorf("invalid type %q (must be one of %s)", r.Type, strings.Join(RequestTypes, ", "))
+	}
+	r.Description = StripDescriptionComments(r.Description)
+	return r.NormalizeLinks()
 }
 
 // ReviewHistoryEntry represents a review history entry (alias from entity)
@@ -31,24 +67,97 @@ const (
 	HistoryFilterAll HistoryFilter = iota
 	HistoryFilterPending
 	HistoryFilterCompleted
+	HistoryFilterDrafts
 )
 
+// Criteria returns the history query matching the filter
+func (f HistoryFilter) Criteria() HistoryCriteria {
+	var criteria HistoryCriteria
+	switch f {
+	case HistoryFilterPending, HistoryFilterDrafts:
+		submitted := false
+		criteria.SubmittedToCollab = &submitted
+		criteria.Drafts = f == HistoryFilterDrafts
+	case HistoryFilterCompleted:
+		submitted := true
+		criteria.SubmittedToCollab = &submitted
+	}
+	return criteria
+}
+
+// HistoryCriteria is a type alias for repository.ReviewHistoryCriteria
+type HistoryCriteria = repository.ReviewHistoryCriteria
+
+// HistorySort is a type alias for repository.HistorySort
+type HistorySort = repository.HistorySort
+
+// HistorySorts lists the supported history sort orders
+var HistorySorts = []HistorySort{repository.HistorySortSubmitted, repository.HistorySortPriority, repository.HistorySortAge}
+
 // Review defines the review usecase interface
 type Review interface {
 	// SubmitReviewRequest submits a new review request to tech lead
 	SubmitReviewRequest(ctx context.Context, req *ReviewRequest, withSend bool) (*ReviewHistoryEntry, error)
 
 	// FormatReviewRequestMessage formats review request for preview/sending
-	FormatReviewRequestMessage(entry *ReviewHistoryEntry) string
+	FormatReviewRequestMessage(entry *ReviewHistoryEntry) (string, error)
 
-	// GetHistories retrieves review histories with optional filter
+	// SaveDraft creates (empty draftID) or updates a draft review request without sending it
+	SaveDraft(ctx context.Context, draftID string, req *ReviewRequest) (*ReviewHistoryEntry, error)
+
+	// SubmitDraft submits a stored draft to tech lead, keeping its ID
+	SubmitDraft(ctx context.Context, draftID string, req *ReviewRequest) (*ReviewHistoryEntry, error)
+
+	// DeleteDraft discards a stored draft
+	DeleteDraft(ctx context.Context, draftID string) error
+
+	// AmendReviewRequest updates a submitted request, keeps a revision and posts an update message.
+	// With withSend=false it only returns the amended entry and message as a preview.
+	AmendReviewRequest(ctx context.Context, historyID string, req *ReviewRequest, withSend bool) (*ReviewHistoryEntry, string, error)
+
+	// GetHistories retrieves review histories with optional filter.
+	// Drafts are only returned by HistoryFilterDrafts.
 	GetHistories(ctx context.Context, filter HistoryFilter) ([]*ReviewHistoryEntry, error)
 
+	// FindHistories retrieves the review histories matching the criteria
+	FindHistories(ctx context.Context, criteria HistoryCriteria) ([]*ReviewHistoryEntry, error)
+
 	// GetHistoryByID retrieves a specific history by ID
 	GetHistoryByID(ctx context.Context, id string) (*ReviewHistoryEntry, error)
 
-	// SubmitToCollaboration forwards a review request to collaboration channel (head architect)
-	SubmitToCollaboration(ctx context.Context, historyID string) error
+	// SubmitToCollaboration forwards a review request to collaboration channel (head architect).
+	// The request must be approved by tech lead unless force is set.
+	SubmitToCollaboration(ctx context.Context, historyID string, force bool) error
+
+	// ApproveReview records tech lead approval, or architect approval once forwarded
+	ApproveReview(ctx context.Context, historyID string, note string) (*ReviewHistoryEntry, error)
+
+	// RejectReview marks a review as needing changes
+	RejectReview(ctx context.Context, historyID string, note string) (*ReviewHistoryEntry, error)
+
+	// WithdrawReview withdraws a review request
+	WithdrawReview(ctx context.Context, historyID string, note string) (*ReviewHistoryEntry, error)
+
+	// GetStats computes turnaround statistics for submitted requests
+	GetStats(ctx context.Context, opts StatsOptions) (*ReviewStats, error)
+
+	// ExportHistories writes submitted requests in the given format and returns how many were written
+	ExportHistories(ctx context.Context, w io.Writer, opts ExportOptions) (int, error)
+
+	// ImportHistories merges entries from a JSON or CSV export into the history
+	ImportHistories(ctx context.Context, r io.Reader, opts ImportOptions) (*ImportResult, error)
+
+	// FindOverdueReviews returns open requests waiting on a reviewer for longer than their priority SLA
+	FindOverdueReviews(ctx context.Context, now time.Time) ([]*OverdueReview, error)
+
+	// RemindOverdueReviews posts a reminder for every overdue request outside its cooldown
+	RemindOverdueReviews(ctx context.Context, opts RemindOptions) ([]*OverdueReview, error)
+
+	// SyncReviews marks open requests merged or closed from the state of their pull requests
+	SyncReviews(ctx context.Context, opts SyncOptions) (*SyncResult, error)
+
+	// UndeliveredNotifications returns the notifications of a review request still in the outbox
+	UndeliveredNotifications(ctx context.Context, historyID string) ([]*OutboxMessage, error)
 
 	// SendToGChat sends a message to Google Chat webhook
 	SendToGChat(ctx context.Context, webhookURL string, message string) error
@@ -58,13 +167,19 @@ type Review interface {
 type reviewUsecase struct {
 	historyRepo repository.ReviewHistoryRepository
 	gchatUc     GChat
+	outbox      Outbox
+	codeHost    CodeHost
+	jira        Jira
 }
 
 // NewReviewUsecase creates a new review usecase
-func NewReviewUsecase(historyRepo repository.ReviewHistoryRepository, gchatUc GChat) Review {
+func NewReviewUsecase(historyRepo repository.ReviewHistoryRepository, gchatUc GChat, outbox Outbox, codeHost CodeHost, jira Jira) Review {
 	return &reviewUsecase{
 		historyRepo: historyRepo,
 		gchatUc:     gchatUc,
+		outbox:      outbox,
+		codeHost:    codeHost,
+		jira:        jira,
 	}
 }
 
@@ -72,9 +187,18 @@ func NewReviewUsecase(historyRepo repository.ReviewHistoryRepository, gchatUc GC
 func (u *reviewUsecase) SubmitReviewRequest(ctx context.Context, req *ReviewRequest, withSend bool) (*ReviewHistoryEntry, error) {
 	cfg := config.GetConfig()
 
-	// Validate webhook URL only if sending
-	if withSend && cfg.GChatReviewWebhookURL == "" {
-		return nil, fmt.Errorf("GChat review webhook URL is not configured")
+	if err := req.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid review request: %w", err)
+	}
+
+	// Validate description sections and notification channels only if sending
+	if withSend {
+		if err := validateSections(req); err != nil {
+			return nil, fmt.Errorf("invalid review request: %w", err)
+		}
+		if _, err := requestChannels(req, config.StageReview); err != nil {
+			return nil, err
+		}
 	}
 
 	// Generate unique ID
@@ -90,52 +214,83 @@ func (u *reviewUsecase) SubmitReviewRequest(ctx context.Context, req *ReviewRequ
 		Title:             req.Title,
 		Description:       req.Description,
 		Priority:          req.Priority,
+		Type:              req.Type,
 		ReviewLinks:       req.ReviewLinks,
 		JiraLinks:         req.JiraLinks,
+		ProjectDir:        req.ProjectDir,
+		Status:            entity.ReviewStatusSubmitted,
 		SubmittedBy:       cfg.UserName,
 		SubmittedByEmail:  cfg.UserEmail,
 		SubmittedAt:       now,
 		SubmittedToCollab: false,
 	}
+	u.refreshPullRequests(ctx, entry)
+	// Missing Jira issues only block sending; the preview shows them
+	jiraErr := u.refreshJiraIssues(ctx, entry)
 
 	// If not sending, return preview only
 	if !withSend {
 		return entry, nil
 	}
-
-	// Save to repository
-	if err := u.historyRepo.Save(ctx, entry); err != nil {
-		return nil, fmt.Errorf("save history: %w", err)
+	if jiraErr != nil {
+		return nil, fmt.Errorf("invalid review request: %w", jiraErr)
 	}
 
-	// Send to GChat (Tech Lead)
-	message := formatReviewRequestMessage(entry)
-	if err := u.gchatUc.SendMessage(ctx, cfg.GChatReviewWebhookURL, message); err != nil {
-		return nil, fmt.Errorf("send to GChat: %w", err)
+	message, custom, err := formatReviewRequestMessage(entry)
+	if err != nil {
+		return nil, err
+	}
+	entry.AppendEvent(entity.ReviewEvent{
+		Type:    entity.ReviewEventSubmitted,
+		Actor:   cfg.UserName,
+		At:      now,
+		Status:  entity.ReviewStatusSubmitted,
+		Message: message,
+	})
+
+	// Save to repository and notify the tech lead
+	notification := newReviewRequestNotification(entry, message, !custom)
+	if err := u.publish(ctx, entry, u.historyRepo.Save, stageNotification{config.StageReview, notification}); err != nil {
+		return nil, err
+	}
+	if err := u.updateJiraIssues(ctx, entry, config.StageReview); err != nil {
+		return nil, err
 	}
 
 	return entry, nil
 }
 
 // FormatReviewRequestMessage formats review request for preview/sending
-func (u *reviewUsecase) FormatReviewRequestMessage(entry *ReviewHistoryEntry) string {
-	return formatReviewRequestMessage(entry)
+func (u *reviewUsecase) FormatReviewRequestMessage(entry *ReviewHistoryEntry) (string, error) {
+	message, _, err := formatReviewRequestMessage(entry)
+	return message, err
 }
 
 // GetHistories retrieves review histories with optional filter
 func (u *reviewUsecase) GetHistories(ctx context.Context, filter HistoryFilter) ([]*ReviewHistoryEntry, error) {
-	var entries []*entity.ReviewHistoryEntry
-	var err error
+	return u.FindHistories(ctx, filter.Criteria())
+}
 
-	switch filter {
-	case HistoryFilterPending:
-		entries, err = u.historyRepo.FindByCollabStatus(ctx, false)
-	case HistoryFilterCompleted:
-		entries, err = u.historyRepo.FindByCollabStatus(ctx, true)
-	default:
-		entries, err = u.historyRepo.FindAll(ctx)
+// FindHistories retrieves the review histories matching the criteria
+func (u *reviewUsecase) FindHistories(ctx context.Context, criteria HistoryCriteria) ([]*ReviewHistoryEntry, error) {
+	priorities := make([]string, 0, len(criteria.Priorities))
+	for _, priority := range criteria.Priorities {
+		priority = NormalizePriority(priority)
+		if !slices.Contains(Priorities, priority) {
+			return nil, fmt.Errorf("invalid priority %q (must be one of %s)", priority, strings.Join(Priorities, ", "))
+		}
+		priorities = append(priorities, priority)
 	}
+	criteria.Priorities = priorities
 
+	if criteria.Sort != repository.HistorySortNone && !slices.Contains(HistorySorts, criteria.Sort) {
+		return nil, fmt.Errorf("invalid sort %q (must be submitted, priority or age)", criteria.Sort)
+	}
+	if criteria.Limit < 0 {
+		return nil, fmt.Errorf("invalid limit %d", criteria.Limit)
+	}
+
+	entries, err := u.historyRepo.FindByCriteria(ctx, criteria)
 	if err != nil {
 		return nil, fmt.Errorf("get histories: %w", err)
 	}
@@ -145,7 +300,7 @@ func (u *reviewUsecase) GetHistories(ctx context.Context, filter HistoryFilter)
 
 // GetHistoryByID retrieves a specific history by ID
 func (u *reviewUsecase) GetHistoryByID(ctx context.Context, id string) (*ReviewHistoryEntry, error) {
-	entry, err := u.historyRepo.FindByID(ctx, id)
+	entry, err := u.findEntry(ctx, id)
 	if err != nil {
 		return nil, fmt.Errorf("get history by ID: %w", err)
 	}
@@ -154,37 +309,59 @@ func (u *reviewUsecase) GetHistoryByID(ctx context.Context, id string) (*ReviewH
 }
 
 // SubmitToCollaboration forwards a review request to collaboration channel (head architect)
-func (u *reviewUsecase) SubmitToCollaboration(ctx context.Context, historyID string) error {
+func (u *reviewUsecase) SubmitToCollaboration(ctx context.Context, historyID string, force bool) error {
 	cfg := config.GetConfig()
 
-	// Validate webhook URL
-	if cfg.GChatCollabWebhookURL == "" {
-		return fmt.Errorf("GChat collaboration webhook URL is not configured")
-	}
-
 	// Get history entry
-	entry, err := u.historyRepo.FindByID(ctx, historyID)
+	entry, err := u.findEntry(ctx, historyID)
 	if err != nil {
 		return fmt.Errorf("get history: %w", err)
 	}
 
-	// Send to GChat (Head Architect)
-	message := formatCollaborationMessage(entry)
-	if err := u.gchatUc.SendMessage(ctx, cfg.GChatCollabWebhookURL, message); err != nil {
-		return fmt.Errorf("send to GChat: %w", err)
+	// Validate notification channels
+	if _, err := RouteChannels(entry, config.StageCollab); err != nil {
+		return err
+	}
+
+	if err := ValidateForward(entry, force); err != nil {
+		return err
 	}
 
 	// Update history entry
 	now := time.Now()
+	u.refreshPullRequests(ctx, entry)
+	_ = u.refreshJiraIssues(ctx, entry)
+	message, custom, err := formatCollaborationMessage(entry, now)
+	if err != nil {
+		return err
+	}
+	notification := newCollaborationNotification(entry, message, now.Format("2006-01-02 15:04:05"), !custom)
+	applyStatus(entry, entity.ReviewStatusForwarded, now)
 	entry.SubmittedToCollab = true
 	entry.SubmittedToCollabAt = &now
 	entry.SubmittedToCollabBy = cfg.UserName
-
-	if err := u.historyRepo.Update(ctx, entry); err != nil {
-		return fmt.Errorf("update history: %w", err)
+	entry.AppendEvent(entity.ReviewEvent{
+		Type:    entity.ReviewEventForwarded,
+		Actor:   cfg.UserName,
+		At:      now,
+		Status:  entity.ReviewStatusForwarded,
+		Message: message,
+	})
+
+	// Persist the forward and notify the head architect
+	if err := u.publish(ctx, entry, u.historyRepo.Update, stageNotification{config.StageCollab, notification}); err != nil {
+		return err
 	}
+	return u.updateJiraIssues(ctx, entry, config.StageCollab)
+}
 
-	return nil
+// UndeliveredNotifications returns the notifications of a review request still in the outbox
+func (u *reviewUsecase) UndeliveredNotifications(ctx context.Context, historyID string) ([]*OutboxMessage, error) {
+	entry, err := u.findEntry(ctx, historyID)
+	if err != nil {
+		return nil, fmt.Errorf("get history: %w", err)
+	}
+	return u.outbox.Undelivered(ctx, entry.ID)
 }
 
 // SendToGChat sends a message to Google Chat webhook
@@ -194,6 +371,28 @@ func (u *reviewUsecase) SendToGChat(ctx context.Context, webhookURL string, mess
 
 // Helper functions
 
+// refreshPullRequests fetches the code host details of the entry's review links. Links that
+// cannot be looked up (no token, unsupported host, API errors) keep their previous details,
+// so the code host never blocks a request.
+func (u *reviewUsecase) refreshPullRequests(ctx context.Context, entry *ReviewHistoryEntry) {
+	if u.codeHost == nil {
+		return
+	}
+
+	var pullRequests []PullRequestInfo
+	for _, link := range entry.ReviewLinks {
+		info, err := u.codeHost.GetPullRequest(ctx, link)
+		if err != nil {
+			if previous := entry.PullRequest(link); previous != nil {
+				pullRequests = append(pullRequests, *previous)
+			}
+			continue
+		}
+		pullRequests = append(pullRequests, *info)
+	}
+	entry.PullRequests = pullRequests
+}
+
 func generateID() (string, error) {
 	bytes := make([]byte, 16)
 	if _, err := rand.Read(bytes); err != nil {
@@ -202,67 +401,14 @@ func generateID() (string, error) {
 	return hex.EncodeToString(bytes), nil
 }
 
-func formatReviewRequestMessage(entry *entity.ReviewHistoryEntry) string {
-	msg := fmt.Sprintf("🔍 *New Review Request*\n\n")
-	msg += fmt.Sprintf("*Title:* %s\n", entry.Title)
-	msg += fmt.Sprintf("*Priority:* %s\n", entry.Priority)
-	msg += fmt.Sprintf("*Submitted by:* %s (%s)\n", entry.SubmittedBy, entry.SubmittedByEmail)
-	msg += fmt.Sprintf("*Submitted at:* %s\n\n", entry.SubmittedAt.Format("2006-01-02 15:04:05"))
-
-	if entry.Description != "" {
-		msg += fmt.Sprintf("*Description:*\n%s\n\n", entry.Description)
-	}
-
-	if len(entry.ReviewLinks) > 0 {
-		msg += "*Review Links:*\n"
-		for _, link := range entry.ReviewLinks {
-			msg += fmt.Sprintf("• %s\n", link)
-		}
-		msg += "\n"
-	}
-
-	if len(entry.JiraLinks) > 0 {
-		msg += "*Jira Links:*\n"
-		for _, link := range entry.JiraLinks {
-			msg += fmt.Sprintf("• %s\n", link)
-		}
-		msg += "\n"
-	}
-
-	msg += fmt.Sprintf("*Request ID:* `%s`\n", entry.ID)
-
-	return msg
+// formatReviewRequestMessage renders the review request message, reporting whether a
+// user template was used
+func formatReviewRequestMessage(entry *entity.ReviewHistoryEntry) (string, bool, error) {
+	return renderMessage(TemplateReviewRequest, MessageTemplateData{ReviewHistoryEntry: entry})
 }
 
-func formatCollaborationMessage(entry *entity.ReviewHistoryEntry) string {
-	msg := fmt.Sprintf("🚀 *Review Request*\n\n")
-	msg += fmt.Sprintf("*Title:* %s\n", entry.Title)
-	msg += fmt.Sprintf("*Priority:* %s\n", entry.Priority)
-	msg += fmt.Sprintf("*Originally submitted by:* %s (%s)\n", entry.SubmittedBy, entry.SubmittedByEmail)
-	msg += fmt.Sprintf("*Tech Lead Approved:* ✅\n")
-	msg += fmt.Sprintf("*Forwarded at:* %s\n\n", time.Now().Format("2006-01-02 15:04:05"))
-
-	if entry.Description != "" {
-		msg += fmt.Sprintf("*Description:*\n%s\n\n", entry.Description)
-	}
-
-	if len(entry.ReviewLinks) > 0 {
-		msg += "*Review Links:*\n"
-		for _, link := range entry.ReviewLinks {
-			msg += fmt.Sprintf("• %s\n", link)
-		}
-		msg += "\n"
-	}
-
-	if len(entry.JiraLinks) > 0 {
-		msg += "*Jira Links:*\n"
-		for _, link := range entry.JiraLinks {
-			msg += fmt.Sprintf("• %s\n", link)
-		}
-		msg += "\n"
-	}
-
-	msg += fmt.Sprintf("*Request ID:* `%s`\n", entry.ID)
-
-	return msg
+// formatCollaborationMessage renders the collaboration message, reporting whether a
+// user template was used
+func formatCollaborationMessage(entry *entity.ReviewHistoryEntry, forwardedAt time.Time) (string, bool, error) {
+	return renderMessage(TemplateCollaboration, MessageTemplateData{ReviewHistoryEntry: entry, ForwardedAt: forwardedAt})
 }
@@ -0,0 +1,244 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/yatbfi/cool/config"
+	"github.com/yatbfi/cool/internal/domain/entity"
+)
+
+// ReviewChanges describes how an amendment differs from the stored request
+type ReviewChanges struct {
+	OldTitle           string
+	NewTitle           string
+	DescriptionChanged bool
+	OldPriority        string
+	NewPriority        string
+	OldType            string
+	NewType            string
+	AddedReviewLinks   []string
+	RemovedReviewLinks []string
+	AddedJiraLinks     []string
+	RemovedJiraLinks   []string
+}
+
+// TitleChanged reports whether the title was changed
+func (c *ReviewChanges) TitleChanged() bool {
+	return c.OldTitle != c.NewTitle
+}
+
+// PriorityChanged reports whether the priority was changed
+func (c *ReviewChanges) PriorityChanged() bool {
+	return c.OldPriority != c.NewPriority
+}
+
+// TypeChanged reports whether the request type was changed
+func (c *ReviewChanges) TypeChanged() bool {
+	return c.OldType != c.NewType
+}
+
+// HasChanges reports whether anything was changed at all
+func (c *ReviewChanges) HasChanges() bool {
+	return c.TitleChanged() || c.DescriptionChanged || c.PriorityChanged() || c.TypeChanged() ||
+		len(c.AddedReviewLinks) > 0 || len(c.RemovedReviewLinks) > 0 ||
+		len(c.AddedJiraLinks) > 0 || len(c.RemovedJiraLinks) > 0
+}
+
+// DiffReviewRequest compares a stored entry with an edited request
+func DiffReviewRequest(entry *ReviewHistoryEntry, req *ReviewRequest) *ReviewChanges {
+	return &ReviewChanges{
+		OldTitle:           entry.Title,
+		NewTitle:           req.Title,
+		DescriptionChanged: entry.Description != req.Description,
+		OldPriority:        entry.Priority,
+		NewPriority:        req.Priority,
+		OldType:            entry.Type,
+		NewType:            req.Type,
+		AddedReviewLinks:   missingFrom(req.ReviewLinks, entry.ReviewLinks),
+		RemovedReviewLinks: missingFrom(entry.ReviewLinks, req.ReviewLinks),
+		AddedJiraLinks:     missingFrom(req.JiraLinks, entry.JiraLinks),
+		RemovedJiraLinks:   missingFrom(entry.JiraLinks, req.JiraLinks),
+	}
+}
+
+// ValidateAmend checks whether an entry can still be amended
+func ValidateAmend(entry *ReviewHistoryEntry) error {
+	status := entry.CurrentStatus()
+	if status == entity.ReviewStatusDraft {
+		return fmt.Errorf("review %s is a draft; use 'cool review request --resume %s' instead", entry.ID, entry.ID)
+	}
+	if status.IsTerminal() {
+		return fmt.Errorf("review %s cannot be amended (status: %s)", entry.ID, status)
+	}
+	return nil
+}
+
+// AmendReviewRequest applies an edited request to a submitted entry. The previous content is kept
+// as a revision and an "updated request" message is posted to the review channel, and to the
+// collaboration channel when the request was already forwarded. A request that had changes
+// requested goes back to submitted. With withSend=false nothing is stored or sent and the
+// returned entry and message are a preview.
+func (u *reviewUsecase) AmendReviewRequest(ctx context.Context, historyID string, req *ReviewRequest, withSend bool) (*ReviewHistoryEntry, string, error) {
+	cfg := config.GetConfig()
+
+	if err := req.Validate(); err != nil {
+		return nil, "", fmt.Errorf("invalid review request: %w", err)
+	}
+
+	entry, err := u.findEntry(ctx, historyID)
+	if err != nil {
+		return nil, "", fmt.Errorf("get history: %w", err)
+	}
+	if err := ValidateAmend(entry); err != nil {
+		return nil, "", err
+	}
+
+	changes := DiffReviewRequest(entry, req)
+	if !changes.HasChanges() {
+		return nil, "", fmt.Errorf("no changes to amend")
+	}
+
+	now := time.Now()
+	entry.Revisions = append(entry.Revisions, entity.ReviewRevision{
+		Revision:    entry.CurrentRevision(),
+		Title:       entry.Title,
+		Description: entry.Description,
+		Priority:    entry.Priority,
+		Type:        entry.Type,
+		ReviewLinks: entry.ReviewLinks,
+		JiraLinks:   entry.JiraLinks,
+		AmendedAt:   now,
+		AmendedBy:   cfg.UserName,
+	})
+	applyRequest(entry, req)
+	entry.UpdatedAt = &now
+	u.refreshPullRequests(ctx, entry)
+	jiraErr := u.refreshJiraIssues(ctx, entry)
+	if entry.CurrentStatus() == entity.ReviewStatusChangesRequested {
+		applyStatus(entry, entity.ReviewStatusSubmitted, now)
+	}
+
+	message := formatAmendmentMessage(entry, changes, now)
+	if !withSend {
+		return entry, message, nil
+	}
+	if jiraErr != nil {
+		return nil, "", fmt.Errorf("invalid review request: %w", jiraErr)
+	}
+
+	if err := validateSections(req); err != nil {
+		return nil, "", fmt.Errorf("invalid review request: %w", err)
+	}
+	if _, err := RouteChannels(entry, config.StageReview); err != nil {
+		return nil, "", err
+	}
+
+	entry.AppendEvent(entity.ReviewEvent{
+		Type:    entity.ReviewEventAmended,
+		Actor:   cfg.UserName,
+		At:      now,
+		Status:  entry.CurrentStatus(),
+		Note:    fmt.Sprintf("revision %d", entry.CurrentRevision()),
+		Message: message,
+	})
+
+	// Reply in the existing threads so the update stays next to the original request
+	notification := newTextNotification(NotificationAmendment, entry, message)
+	notifications := []stageNotification{{config.StageReview, notification}}
+	if entry.SubmittedToCollab && hasStageChannels(entry, config.StageCollab) {
+		notifications = append(notifications, stageNotification{config.StageCollab, notification})
+	}
+
+	if err := u.publish(ctx, entry, u.historyRepo.Update, notifications...); err != nil {
+		return nil, "", err
+	}
+
+	return entry, message, nil
+}
+
+// missingFrom returns the items of a that are not in b
+func missingFrom(a, b []string) []string {
+	var missing []string
+	for _, item := range a {
+		if !slices.Contains(b, item) {
+			missing = append(missing, item)
+		}
+	}
+	return missing
+}
+
+func formatAmendmentMessage(entry *entity.ReviewHistoryEntry, changes *ReviewChanges, now time.Time) string {
+	cfg := config.GetConfig()
+
+	msg := "✏️ *Updated Review Request*\n\n"
+	msg += fmt.Sprintf("*Title:* %s\n", entry.Title)
+	msg += fmt.Sprintf("*Priority:* %s\n", entry.Priority)
+	if entry.Type != "" {
+		msg += fmt.Sprintf("*Type:* %s\n", RequestTypeLabel(entry.Type))
+	}
+	msg += fmt.Sprintf("*Updated by:* %s (%s)\n", cfg.UserName, cfg.UserEmail)
+	msg += fmt.Sprintf("*Updated at:* %s\n", now.Format("2006-01-02 15:04:05"))
+	msg += fmt.Sprintf("*Revision:* %d\n\n", entry.CurrentRevision())
+
+	msg += "*What changed:*\n"
+	if changes.TitleChanged() {
+		msg += fmt.Sprintf("• Title: ~%s~ → %s\n", changes.OldTitle, changes.NewTitle)
+	}
+	if changes.PriorityChanged() {
+		msg += fmt.Sprintf("• Priority: %s → %s\n", changes.OldPriority, changes.NewPriority)
+	}
+	if changes.TypeChanged() {
+		msg += fmt.Sprintf("• Type: %s → %s\n", formatOptionalType(changes.OldType), formatOptionalType(changes.NewType))
+	}
+	if changes.DescriptionChanged {
+		msg += "• Description updated\n"
+	}
+	for _, link := range changes.AddedReviewLinks {
+		msg += fmt.Sprintf("• ➕ PR: %s\n", link)
+	}
+	for _, link := range changes.RemovedReviewLinks {
+		msg += fmt.Sprintf("• ➖ PR: %s\n", link)
+	}
+	for _, link := range changes.AddedJiraLinks {
+		msg += fmt.Sprintf("• ➕ Jira: %s\n", link)
+	}
+	for _, link := range changes.RemovedJiraLinks {
+		msg += fmt.Sprintf("• ➖ Jira: %s\n", link)
+	}
+	msg += "\n"
+
+	if changes.DescriptionChanged && entry.Description != "" {
+		msg += fmt.Sprintf("*Description:*\n%s\n\n", entry.Description)
+	}
+
+	if len(entry.ReviewLinks) > 0 {
+		msg += "*Review Links:*\n"
+		for _, link := range entry.ReviewLinks {
+			msg += fmt.Sprintf("• %s\n", link)
+		}
+		msg += "\n"
+	}
+
+	if len(entry.JiraLinks) > 0 {
+		msg += "*Jira Links:*\n"
+		for _, link := range entry.JiraLinks {
+			msg += fmt.Sprintf("• %s\n", link)
+		}
+		msg += "\n"
+	}
+
+	msg += fmt.Sprintf("*Request ID:* `%s`\n", entry.ID)
+
+	return msg
+}
+
+// formatOptionalType returns the label of a request type, or "none" for untyped requests
+func formatOptionalType(requestType string) string {
+	if requestType == "" {
+		return "none"
+	}
+	return RequestTypeLabel(requestType)
+}
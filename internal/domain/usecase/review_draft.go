@@ -0,0 +1,161 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/yatbfi/cool/config"
+	"github.com/yatbfi/cool/internal/domain/entity"
+)
+
+// ReviewRequestFromEntry converts a stored entry back into an editable request
+func ReviewRequestFromEntry(entry *ReviewHistoryEntry) *ReviewRequest {
+	return &ReviewRequest{
+		Title:       entry.Title,
+		Description: entry.Description,
+		Priority:    entry.Priority,
+		Type:        entry.Type,
+		ReviewLinks: append([]string(nil), entry.ReviewLinks...),
+		JiraLinks:   append([]string(nil), entry.JiraLinks...),
+		ProjectDir:  entry.ProjectDir,
+	}
+}
+
+// SaveDraft creates (empty draftID) or updates a draft review request without sending it.
+// Drafts are not validated so partially filled requests can be kept.
+func (u *reviewUsecase) SaveDraft(ctx context.Context, draftID string, req *ReviewRequest) (*ReviewHistoryEntry, error) {
+	cfg := config.GetConfig()
+	now := time.Now()
+
+	if draftID != "" {
+		entry, err := u.getDraft(ctx, draftID)
+		if err != nil {
+			return nil, err
+		}
+
+		applyRequest(entry, req)
+		entry.UpdatedAt = &now
+		if err := u.historyRepo.Update(ctx, entry); err != nil {
+			return nil, fmt.Errorf("update draft: %w", err)
+		}
+		return entry, nil
+	}
+
+	id, err := generateID()
+	if err != nil {
+		return nil, fmt.Errorf("generate ID: %w", err)
+	}
+
+	entry := &entity.ReviewHistoryEntry{
+		ID:               id,
+		Status:           entity.ReviewStatusDraft,
+		SubmittedBy:      cfg.UserName,
+		SubmittedByEmail: cfg.UserEmail,
+		SubmittedAt:      now,
+		UpdatedAt:        &now,
+	}
+	applyRequest(entry, req)
+	entry.AppendEvent(entity.ReviewEvent{
+		Type:   entity.ReviewEventDrafted,
+		Actor:  cfg.UserName,
+		At:     now,
+		Status: entity.ReviewStatusDraft,
+	})
+
+	if err := u.historyRepo.Save(ctx, entry); err != nil {
+		return nil, fmt.Errorf("save draft: %w", err)
+	}
+	return entry, nil
+}
+
+// SubmitDraft submits a stored draft to tech lead, keeping its ID
+func (u *reviewUsecase) SubmitDraft(ctx context.Context, draftID string, req *ReviewRequest) (*ReviewHistoryEntry, error) {
+	cfg := config.GetConfig()
+
+	if err := req.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid review request: %w", err)
+	}
+	if err := validateSections(req); err != nil {
+		return nil, fmt.Errorf("invalid review request: %w", err)
+	}
+	if _, err := requestChannels(req, config.StageReview); err != nil {
+		return nil, err
+	}
+
+	entry, err := u.getDraft(ctx, draftID)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	applyRequest(entry, req)
+	applyStatus(entry, entity.ReviewStatusSubmitted, now)
+	entry.SubmittedAt = now
+	u.refreshPullRequests(ctx, entry)
+	if err := u.refreshJiraIssues(ctx, entry); err != nil {
+		return nil, fmt.Errorf("invalid review request: %w", err)
+	}
+
+	message, custom, err := formatReviewRequestMessage(entry)
+	if err != nil {
+		return nil, err
+	}
+	entry.AppendEvent(entity.ReviewEvent{
+		Type:    entity.ReviewEventSubmitted,
+		Actor:   cfg.UserName,
+		At:      now,
+		Status:  entity.ReviewStatusSubmitted,
+		Message: message,
+	})
+
+	// Save the submitted draft and notify the tech lead
+	notification := newReviewRequestNotification(entry, message, !custom)
+	if err := u.publish(ctx, entry, u.historyRepo.Update, stageNotification{config.StageReview, notification}); err != nil {
+		return nil, err
+	}
+	if err := u.updateJiraIssues(ctx, entry, config.StageReview); err != nil {
+		return nil, err
+	}
+
+	return entry, nil
+}
+
+// DeleteDraft discards a stored draft
+func (u *reviewUsecase) DeleteDraft(ctx context.Context, draftID string) error {
+	entry, err := u.getDraft(ctx, draftID)
+	if err != nil {
+		return err
+	}
+
+	if err := u.historyRepo.Delete(ctx, entry.ID); err != nil {
+		return fmt.Errorf("delete draft: %w", err)
+	}
+	return nil
+}
+
+// getDraft loads an entry and ensures it is still a draft
+func (u *reviewUsecase) getDraft(ctx context.Context, draftID string) (*ReviewHistoryEntry, error) {
+	entry, err := u.findEntry(ctx, draftID)
+	if err != nil {
+		return nil, fmt.Errorf("get draft: %w", err)
+	}
+
+	if status := entry.CurrentStatus(); status != entity.ReviewStatusDraft {
+		return nil, fmt.Errorf("review %s is not a draft (status: %s)", draftID, status)
+	}
+	return entry, nil
+}
+
+// applyRequest copies the editable request fields onto an entry
+func applyRequest(entry *ReviewHistoryEntry, req *ReviewRequest) {
+	entry.Title = req.Title
+	entry.Description = req.Description
+	entry.Priority = req.Priority
+	entry.Type = req.Type
+	entry.ReviewLinks = req.ReviewLinks
+	entry.JiraLinks = req.JiraLinks
+	if req.ProjectDir != "" {
+		entry.ProjectDir = req.ProjectDir
+	}
+}
@@ -0,0 +1,272 @@
+package usecase
+
+import (
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"html/template"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Export formats
+const (
+	ExportFormatCSV      = "csv"
+	ExportFormatJSON     = "json"
+	ExportFormatMarkdown = "md"
+	ExportFormatHTML     = "html"
+)
+
+// ExportFormats lists the supported export formats
+var ExportFormats = []string{ExportFormatCSV, ExportFormatJSON, ExportFormatMarkdown, ExportFormatHTML}
+
+// exportTimeLayout is used for timestamps in CSV exports
+const exportTimeLayout = time.RFC3339
+
+// ExportOptions selects the format and the requests to export by submission time
+type ExportOptions struct {
+	Format string
+	Since  *time.Time
+	Until  *time.Time
+}
+
+// csvHeader is the column layout of CSV exports (also accepted by import)
+var csvHeader = []string{
+	"id", "title", "priority", "type", "status", "description",
+	"submitted_by", "submitted_by_email", "submitted_at", "updated_at",
+	"review_links", "jira_links",
+	"approved_by_tech_lead", "approved_by_architect",
+	"submitted_to_collab", "submitted_to_collab_at", "submitted_to_collab_by",
+	"notes",
+}
+
+// ExportHistories writes submitted requests in the given format and returns how many were written
+func (u *reviewUsecase) ExportHistories(ctx context.Context, w io.Writer, opts ExportOptions) (int, error) {
+	entries, err := u.GetHistories(ctx, HistoryFilterAll)
+	if err != nil {
+		return 0, err
+	}
+
+	entries = filterSubmittedBetween(entries, opts.Since, opts.Until)
+	sort.SliceStable(entries, func(i, j int) bool {
+		return entries[i].SubmittedAt.Before(entries[j].SubmittedAt)
+	})
+
+	switch opts.Format {
+	case ExportFormatCSV:
+		err = exportCSV(w, entries)
+	case ExportFormatJSON:
+		err = exportJSON(w, entries)
+	case ExportFormatMarkdown:
+		err = exportMarkdown(w, entries)
+	case ExportFormatHTML:
+		err = exportHTML(w, entries, opts)
+	default:
+		return 0, fmt.Errorf("invalid export format %q (must be one of %s)", opts.Format, strings.Join(ExportFormats, ", "))
+	}
+	if err != nil {
+		return 0, fmt.Errorf("export %s: %w", opts.Format, err)
+	}
+
+	return len(entries), nil
+}
+
+// filterSubmittedBetween keeps entries submitted in [since, until); nil bounds are open
+func filterSubmittedBetween(entries []*ReviewHistoryEntry, since, until *time.Time) []*ReviewHistoryEntry {
+	var filtered []*ReviewHistoryEntry
+	for _, entry := range entries {
+		if since != nil && entry.SubmittedAt.Before(*since) {
+			continue
+		}
+		if until != nil && !entry.SubmittedAt.Before(*until) {
+			continue
+		}
+		filtered = append(filtered, entry)
+	}
+	return filtered
+}
+
+func exportCSV(w io.Writer, entries []*ReviewHistoryEntry) error {
+	writer := csv.NewWriter(w)
+	if err := writer.Write(csvHeader); err != nil {
+		return err
+	}
+
+	for _, entry := range entries {
+		record := []string{
+			entry.ID,
+			entry.Title,
+			entry.Priority,
+			entry.Type,
+			string(entry.CurrentStatus()),
+			entry.Description,
+			entry.SubmittedBy,
+			entry.SubmittedByEmail,
+			entry.SubmittedAt.Format(exportTimeLayout),
+			formatOptionalTime(entry.UpdatedAt),
+			strings.Join(entry.ReviewLinks, "\n"),
+			strings.Join(entry.JiraLinks, "\n"),
+			strconv.FormatBool(entry.ApprovedByTechLead),
+			strconv.FormatBool(entry.ApprovedByArchitect),
+			strconv.FormatBool(entry.SubmittedToCollab),
+			formatOptionalTime(entry.SubmittedToCollabAt),
+			entry.SubmittedToCollabBy,
+			entry.Notes,
+		}
+		if err := writer.Write(record); err != nil {
+			return err
+		}
+	}
+
+	writer.Flush()
+	return writer.Error()
+}
+
+func exportJSON(w io.Writer, entries []*ReviewHistoryEntry) error {
+	if entries == nil {
+		entries = []*ReviewHistoryEntry{}
+	}
+
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(entries)
+}
+
+func exportMarkdown(w io.Writer, entries []*ReviewHistoryEntry) error {
+	var sb strings.Builder
+	sb.WriteString("| ID | Title | Priority | Status | Submitted | Submitted By | Pull Requests | Jira | Collab Forwarded |\n")
+	sb.WriteString("|----|-------|----------|--------|-----------|--------------|---------------|------|------------------|\n")
+
+	for _, entry := range entries {
+		var prs, tickets []string
+		for _, link := range entry.ReviewLinks {
+			prs = append(prs, fmt.Sprintf("[%s](%s)", prLinkLabel(link), link))
+		}
+		for _, link := range entry.JiraLinks {
+			tickets = append(tickets, fmt.Sprintf("[%s](%s)", jiraLinkLabel(link), link))
+		}
+
+		collab := "-"
+		if entry.SubmittedToCollabAt != nil {
+			collab = entry.SubmittedToCollabAt.Format("2006-01-02 15:04")
+		}
+
+		fmt.Fprintf(&sb, "| `%s` | %s | %s | %s | %s | %s | %s | %s | %s |\n",
+			ShortID(entry.ID),
+			escapeMarkdownCell(entry.Title),
+			escapeMarkdownCell(entry.Priority),
+			entry.CurrentStatus(),
+			entry.SubmittedAt.Format("2006-01-02 15:04"),
+			escapeMarkdownCell(entry.SubmittedBy),
+			strings.Join(prs, "<br>"),
+			strings.Join(tickets, "<br>"),
+			collab,
+		)
+	}
+
+	_, err := io.WriteString(w, sb.String())
+	return err
+}
+
+// escapeMarkdownCell keeps user text from breaking the table layout
+func escapeMarkdownCell(text string) string {
+	text = strings.ReplaceAll(text, "|", `\|`)
+	return strings.ReplaceAll(text, "\n", " ")
+}
+
+var exportHTMLTemplate = template.Must(template.New("export").Funcs(template.FuncMap{
+	"prLabel":   prLinkLabel,
+	"jiraLabel": jiraLinkLabel,
+	"shortID":   ShortID,
+	"formatTime": func(t *time.Time) string {
+		if t == nil {
+			return "-"
+		}
+		return t.Format("2006-01-02 15:04")
+	},
+}).Parse(`<!DOCTYPE html>
+<html lang="en">
+<head>
+<meta charset="utf-8">
+<title>{{.Title}}</title>
+<style>
+  body { font-family: -apple-system, "Segoe UI", Roboto, sans-serif; margin: 2rem; color: #1f2328; }
+  table { border-collapse: collapse; width: 100%; }
+  th, td { border: 1px solid #d0d7de; padding: 6px 10px; text-align: left; vertical-align: top; }
+  th { background: #f6f8fa; }
+  code { font-size: 0.9em; }
+  .muted { color: #656d76; }
+</style>
+</head>
+<body>
+<h1>{{.Title}}</h1>
+<p class="muted">{{.Period}} · {{len .Entries}} request(s) · generated {{.Generated}}</p>
+<table>
+<thead>
+<tr><th>ID</th><th>Title</th><th>Priority</th><th>Status</th><th>Submitted</th><th>Submitted By</th><th>Pull Requests</th><th>Jira</th><th>Collab Forwarded</th></tr>
+</thead>
+<tbody>
+{{- range .Entries}}
+<tr>
+<td><code title="{{.ID}}">{{shortID .ID}}</code></td>
+<td>{{.Title}}</td>
+<td>{{.Priority}}</td>
+<td>{{.CurrentStatus}}</td>
+<td>{{formatTime .SubmittedAtPtr}}</td>
+<td>{{.SubmittedBy}}</td>
+<td>{{range .ReviewLinks}}<a href="{{.}}">{{prLabel .}}</a><br>{{end}}</td>
+<td>{{range .JiraLinks}}<a href="{{.}}">{{jiraLabel .}}</a><br>{{end}}</td>
+<td>{{formatTime .SubmittedToCollabAt}}</td>
+</tr>
+{{- end}}
+</tbody>
+</table>
+</body>
+</html>
+`))
+
+// htmlExportEntry adds template conveniences to an entry
+type htmlExportEntry struct {
+	*ReviewHistoryEntry
+}
+
+// SubmittedAtPtr lets the template format both timestamps with one helper
+func (e htmlExportEntry) SubmittedAtPtr() *time.Time {
+	return &e.SubmittedAt
+}
+
+func exportHTML(w io.Writer, entries []*ReviewHistoryEntry, opts ExportOptions) error {
+	// Until is exclusive, show the last day it includes
+	period := "All time"
+	switch {
+	case opts.Since != nil && opts.Until != nil:
+		period = fmt.Sprintf("%s – %s", opts.Since.Format("2006-01-02"), opts.Until.Add(-time.Second).Format("2006-01-02"))
+	case opts.Since != nil:
+		period = "Since " + opts.Since.Format("2006-01-02")
+	case opts.Until != nil:
+		period = "Until " + opts.Until.Add(-time.Second).Format("2006-01-02")
+	}
+
+	rows := make([]htmlExportEntry, 0, len(entries))
+	for _, entry := range entries {
+		rows = append(rows, htmlExportEntry{entry})
+	}
+
+	return exportHTMLTemplate.Execute(w, map[string]interface{}{
+		"Title":     "Review Requests",
+		"Period":    period,
+		"Generated": time.Now().Format("2006-01-02 15:04"),
+		"Entries":   rows,
+	})
+}
+
+func formatOptionalTime(t *time.Time) string {
+	if t == nil {
+		return ""
+	}
+	return t.Format(exportTimeLayout)
+}
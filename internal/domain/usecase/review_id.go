@@ -0,0 +1,62 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"strings"
+)
+
+// Review IDs can be shortened to any unique prefix of at least this many characters,
+// like git short SHAs
+const (
+	MinIDPrefixLength = 4
+	ShortIDLength     = 8
+)
+
+// AmbiguousIDError is returned when a short ID matches more than one review request
+type AmbiguousIDError struct {
+	Prefix     string
+	Candidates []*ReviewHistoryEntry
+}
+
+func (e *AmbiguousIDError) Error() string {
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "review ID %q is ambiguous, it matches %d requests:", e.Prefix, len(e.Candidates))
+	for _, entry := range e.Candidates {
+		fmt.Fprintf(&sb, "\n  %s  %s (%s)", entry.ID, entry.Title, entry.CurrentStatus())
+	}
+	sb.WriteString("\nuse a longer prefix")
+	return sb.String()
+}
+
+// ShortID returns the abbreviated form of a review ID used in listings
+func ShortID(id string) string {
+	if len(id) > ShortIDLength {
+		return id[:ShortIDLength]
+	}
+	return id
+}
+
+// findEntry resolves a full or abbreviated review ID to its history entry
+func (u *reviewUsecase) findEntry(ctx context.Context, id string) (*ReviewHistoryEntry, error) {
+	prefix := strings.ToLower(strings.TrimSpace(id))
+	if prefix == "" {
+		return nil, fmt.Errorf("review ID is empty")
+	}
+
+	matches, err := u.historyRepo.FindByIDPrefix(ctx, prefix)
+	if err != nil {
+		return nil, err
+	}
+
+	switch {
+	case len(matches) == 1 && (matches[0].ID == prefix || len(prefix) >= MinIDPrefixLength):
+		return matches[0], nil
+	case len(matches) == 0:
+		return nil, fmt.Errorf("review %s not found", id)
+	case len(prefix) < MinIDPrefixLength:
+		return nil, fmt.Errorf("review ID %q is too short, use at least %d characters", id, MinIDPrefixLength)
+	default:
+		return nil, &AmbiguousIDError{Prefix: id, Candidates: matches}
+	}
+}
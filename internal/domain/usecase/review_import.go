@@ -0,0 +1,343 @@
+package usecase
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/yatbfi/cool/internal/domain/entity"
+)
+
+// Import formats. The native review_histories.json and `review export --format json`
+// share the JSON layout; CSV must use the `review export` columns.
+const (
+	ImportFormatJSON = ExportFormatJSON
+	ImportFormatCSV  = ExportFormatCSV
+)
+
+// ImportOptions controls how an import is merged into the history
+type ImportOptions struct {
+	Format string
+	DryRun bool // report what would change without saving
+}
+
+// ImportResult reports how the imported entries were merged
+type ImportResult struct {
+	Added   []*ReviewHistoryEntry
+	Updated []*ReviewHistoryEntry
+	Skipped []SkippedImport
+}
+
+// SkippedImport is an imported entry that was not applied
+type SkippedImport struct {
+	Entry  *ReviewHistoryEntry
+	Reason string
+}
+
+// DetectImportFormat guesses the format of import data from the file name, then its content
+func DetectImportFormat(name string, data []byte) string {
+	switch {
+	case strings.HasSuffix(strings.ToLower(name), ".json"):
+		return ImportFormatJSON
+	case strings.HasSuffix(strings.ToLower(name), ".csv"):
+		return ImportFormatCSV
+	}
+
+	trimmed := bytes.TrimSpace(data)
+	if len(trimmed) > 0 && (trimmed[0] == '[' || trimmed[0] == '{') {
+		return ImportFormatJSON
+	}
+	return ImportFormatCSV
+}
+
+// ImportHistories merges review history entries into the local history. Entries are matched
+// on ID; when both sides have an entry the most recently updated one wins.
+func (u *reviewUsecase) ImportHistories(ctx context.Context, r io.Reader, opts ImportOptions) (*ImportResult, error) {
+	var imported []*ReviewHistoryEntry
+	var err error
+	switch opts.Format {
+	case ImportFormatJSON:
+		imported, err = parseImportJSON(r)
+	case ImportFormatCSV:
+		imported, err = parseImportCSV(r)
+	default:
+		return nil, fmt.Errorf("invalid import format %q (must be json or csv)", opts.Format)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("parse %s: %w", opts.Format, err)
+	}
+
+	existing, err := u.historyRepo.FindAll(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("get histories: %w", err)
+	}
+	byID := make(map[string]*ReviewHistoryEntry, len(existing))
+	for _, entry := range existing {
+		byID[entry.ID] = entry
+	}
+
+	// CSV rows carry no events, revisions, threads, project dir or fetched pull request and
+	// Jira details; keep the local ones. Fields added to entries without a CSV column
+	// belong here too.
+	partial := opts.Format == ImportFormatCSV
+
+	result := &ImportResult{}
+	for _, entry := range dedupeImported(imported, result) {
+		current, ok := byID[entry.ID]
+		if !ok {
+			if !opts.DryRun {
+				if err := u.historyRepo.Save(ctx, entry); err != nil {
+					return result, fmt.Errorf("save %s: %w", entry.ID, err)
+				}
+			}
+			result.Added = append(result.Added, entry)
+			continue
+		}
+
+		switch imported, local := lastModified(entry), lastModified(current); {
+		case imported.Equal(local):
+			result.Skipped = append(result.Skipped, SkippedImport{Entry: entry, Reason: "already up to date"})
+			continue
+		case imported.Before(local):
+			result.Skipped = append(result.Skipped, SkippedImport{Entry: entry, Reason: "local entry is newer"})
+			continue
+		}
+
+		if partial {
+			entry.Events = current.Events
+			entry.Revisions = current.Revisions
+			entry.Threads = current.Threads
+			entry.ProjectDir = current.ProjectDir
+			entry.PullRequests = current.PullRequests
+			entry.JiraIssues = current.JiraIssues
+		}
+		if !opts.DryRun {
+			if err := u.historyRepo.Update(ctx, entry); err != nil {
+				return result, fmt.Errorf("update %s: %w", entry.ID, err)
+			}
+		}
+		result.Updated = append(result.Updated, entry)
+	}
+
+	return result, nil
+}
+
+// dedupeImported keeps the most recently updated entry of each ID in the import,
+// recording the others as skipped
+func dedupeImported(entries []*ReviewHistoryEntry, result *ImportResult) []*ReviewHistoryEntry {
+	index := map[string]int{}
+	var unique []*ReviewHistoryEntry
+	for _, entry := range entries {
+		i, ok := index[entry.ID]
+		if !ok {
+			index[entry.ID] = len(unique)
+			unique = append(unique, entry)
+			continue
+		}
+
+		older := entry
+		if lastModified(entry).After(lastModified(unique[i])) {
+			older, unique[i] = unique[i], entry
+		}
+		result.Skipped = append(result.Skipped, SkippedImport{Entry: older, Reason: "duplicate in import"})
+	}
+	return unique
+}
+
+// lastModified returns when an entry last changed: its update time, or its latest event
+// for entries saved before UpdatedAt was maintained
+func lastModified(entry *ReviewHistoryEntry) time.Time {
+	latest := entry.SubmittedAt
+	if entry.UpdatedAt != nil && entry.UpdatedAt.After(latest) {
+		latest = *entry.UpdatedAt
+	}
+	for _, event := range entry.Events {
+		if event.At.After(latest) {
+			latest = event.At
+		}
+	}
+	return latest
+}
+
+func parseImportJSON(r io.Reader) ([]*ReviewHistoryEntry, error) {
+	var entries []*ReviewHistoryEntry
+	if err := json.NewDecoder(r).Decode(&entries); err != nil {
+		return nil, err
+	}
+
+	for i, entry := range entries {
+		if entry == nil {
+			return nil, fmt.Errorf("entry %d: empty entry", i+1)
+		}
+		if err := validateImported(entry); err != nil {
+			return nil, fmt.Errorf("entry %d: %w", i+1, err)
+		}
+	}
+	return entries, nil
+}
+
+func parseImportCSV(r io.Reader) ([]*ReviewHistoryEntry, error) {
+	reader := csv.NewReader(r)
+	header, err := reader.Read()
+	if err != nil {
+		if errors.Is(err, io.EOF) {
+			return nil, errors.New("file is empty")
+		}
+		return nil, err
+	}
+
+	columns := make(map[string]int, len(header))
+	for i, name := range header {
+		columns[strings.TrimSpace(name)] = i
+	}
+	for _, required := range []string{"id", "title", "submitted_at"} {
+		if _, ok := columns[required]; !ok {
+			return nil, fmt.Errorf("missing column %q", required)
+		}
+	}
+
+	var entries []*ReviewHistoryEntry
+	for line := 2; ; line++ {
+		record, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		entry, err := csvRecordEntry(record, columns)
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %w", line, err)
+		}
+		entries = append(entries, entry)
+	}
+	return entries, nil
+}
+
+// csvRecordEntry builds an entry from a CSV row; unknown columns are ignored
+func csvRecordEntry(record []string, columns map[string]int) (*ReviewHistoryEntry, error) {
+	field := func(name string) string {
+		if i, ok := columns[name]; ok && i < len(record) {
+			return strings.TrimSpace(record[i])
+		}
+		return ""
+	}
+
+	entry := &ReviewHistoryEntry{
+		ID:                  field("id"),
+		Title:               field("title"),
+		Priority:            field("priority"),
+		Type:                NormalizeRequestType(field("type")),
+		Status:              entity.ReviewStatus(field("status")),
+		Description:         field("description"),
+		SubmittedBy:         field("submitted_by"),
+		SubmittedByEmail:    field("submitted_by_email"),
+		ReviewLinks:         splitLines(field("review_links")),
+		JiraLinks:           splitLines(field("jira_links")),
+		SubmittedToCollabBy: field("submitted_to_collab_by"),
+		Notes:               field("notes"),
+	}
+
+	var err error
+	if entry.SubmittedAt, err = parseImportTime("submitted_at", field("submitted_at")); err != nil {
+		return nil, err
+	}
+	if entry.UpdatedAt, err = parseOptionalImportTime("updated_at", field("updated_at")); err != nil {
+		return nil, err
+	}
+	if entry.SubmittedToCollabAt, err = parseOptionalImportTime("submitted_to_collab_at", field("submitted_to_collab_at")); err != nil {
+		return nil, err
+	}
+
+	for name, target := range map[string]*bool{
+		"approved_by_tech_lead": &entry.ApprovedByTechLead,
+		"approved_by_architect": &entry.ApprovedByArchitect,
+		"submitted_to_collab":   &entry.SubmittedToCollab,
+	} {
+		value := field(name)
+		if value == "" {
+			continue
+		}
+		if *target, err = strconv.ParseBool(value); err != nil {
+			return nil, fmt.Errorf("%s: invalid boolean %q", name, value)
+		}
+	}
+
+	if err := validateImported(entry); err != nil {
+		return nil, err
+	}
+	return entry, nil
+}
+
+func validateImported(entry *ReviewHistoryEntry) error {
+	if entry.ID == "" {
+		return errors.New("missing id")
+	}
+	if entry.Title == "" {
+		return fmt.Errorf("%s: missing title", entry.ID)
+	}
+	if entry.SubmittedAt.IsZero() {
+		return fmt.Errorf("%s: missing submitted_at", entry.ID)
+	}
+	// Entries saved before statuses were recorded have none; their status is derived
+	if entry.Status != "" && !entry.Status.IsValid() {
+		return fmt.Errorf("%s: invalid status %q (must be one of %s)", entry.ID, entry.Status, joinStatuses(entity.ReviewStatuses))
+	}
+	if entry.Priority != "" {
+		entry.Priority = NormalizePriority(entry.Priority)
+		if !slices.Contains(Priorities, entry.Priority) {
+			return fmt.Errorf("%s: invalid priority %q (must be one of %s)", entry.ID, entry.Priority, strings.Join(Priorities, ", "))
+		}
+	}
+	return nil
+}
+
+func joinStatuses(statuses []ReviewStatus) string {
+	names := make([]string, 0, len(statuses))
+	for _, s := range statuses {
+		names = append(names, string(s))
+	}
+	return strings.Join(names, ", ")
+}
+
+func parseImportTime(name, value string) (time.Time, error) {
+	if value == "" {
+		return time.Time{}, nil
+	}
+	t, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("%s: invalid time %q (expected RFC 3339)", name, value)
+	}
+	return t, nil
+}
+
+func parseOptionalImportTime(name, value string) (*time.Time, error) {
+	if value == "" {
+		return nil, nil
+	}
+	t, err := parseImportTime(name, value)
+	if err != nil {
+		return nil, err
+	}
+	return &t, nil
+}
+
+// splitLines splits a multi-value CSV cell, one value per line
+func splitLines(value string) []string {
+	values := []string{}
+	for _, line := range strings.Split(value, "\n") {
+		if line = strings.TrimSpace(line); line != "" {
+			values = append(values, line)
+		}
+	}
+	return values
+}
@@ -0,0 +1,56 @@
+package usecase
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestParseImportCSVValidatesRows(t *testing.T) {
+	const header = "id,title,priority,status,submitted_at\n"
+	tests := []struct {
+		name    string
+		rows    string
+		wantErr string
+	}{
+		{
+			name: "valid rows",
+			rows: "r1,Fix login,p1,submitted,2025-01-02T10:00:00Z\n" +
+				"r2,Legacy entry,,,2025-01-02T10:00:00Z\n",
+		},
+		{
+			name:    "unknown status",
+			rows:    "r1,Fix login,P1,submitted,2025-01-02T10:00:00Z\nr2,Add retry,P2,foo,2025-01-02T10:00:00Z\n",
+			wantErr: `line 3: r2: invalid status "foo"`,
+		},
+		{
+			name:    "unknown priority",
+			rows:    "r1,Fix login,urgent,submitted,2025-01-02T10:00:00Z\n",
+			wantErr: `line 2: r1: invalid priority "URGENT"`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			entries, err := parseImportCSV(strings.NewReader(header + tt.rows))
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("parseImportCSV() error = %v, want %q", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("parseImportCSV() error = %v", err)
+			}
+			if entries[0].Priority != "P1" {
+				t.Errorf("Priority = %q, want the normalized %q", entries[0].Priority, "P1")
+			}
+		})
+	}
+}
+
+func TestParseImportJSONValidatesStatus(t *testing.T) {
+	_, err := parseImportJSON(strings.NewReader(`[{"id":"r1","title":"Fix login","status":"done","submitted_at":"2025-01-02T10:00:00Z"}]`))
+	if err == nil || !strings.Contains(err.Error(), `entry 1: r1: invalid status "done"`) {
+		t.Fatalf("parseImportJSON() error = %v, want an invalid status error", err)
+	}
+}
@@ -0,0 +1,117 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/yatbfi/cool/config"
+	"github.com/yatbfi/cool/internal/domain/entity"
+	"github.com/yatbfi/cool/internal/pkg/common"
+)
+
+// refreshJiraIssues fetches the Jira details of the entry's Jira links and returns an
+// ErrJiraIssueNotFound error for every issue that does not exist. Other lookup problems
+// (no credentials, API errors) keep the previous details and are not reported, so Jira
+// being unavailable never blocks a request.
+func (u *reviewUsecase) refreshJiraIssues(ctx context.Context, entry *ReviewHistoryEntry) error {
+	if u.jira == nil {
+		return nil
+	}
+
+	var issues []JiraIssueInfo
+	var missing []error
+	for _, link := range entry.JiraLinks {
+		info, err := u.jira.GetIssue(ctx, link)
+		if errors.Is(err, ErrJiraIssueNotFound) {
+			missing = append(missing, err)
+			continue
+		}
+		if err != nil {
+			if previous := entry.JiraIssue(link); previous != nil {
+				issues = append(issues, *previous)
+			}
+			continue
+		}
+		issues = append(issues, *info)
+	}
+	entry.JiraIssues = issues
+	return errors.Join(missing...)
+}
+
+// updateJiraIssues applies the jira_actions of a stage to the entry's issues and records
+// the outcome as a Jira event. Failures are recorded rather than returned: the request
+// has already been sent at this point.
+func (u *reviewUsecase) updateJiraIssues(ctx context.Context, entry *ReviewHistoryEntry, stage string) error {
+	cfg := config.GetConfig()
+	action := cfg.JiraActions[stage]
+	if u.jira == nil || !cfg.JiraAPIConfigured() || (action.Transition == "" && !action.Comment) {
+		return nil
+	}
+
+	var outcomes []string
+	for _, link := range entry.JiraLinks {
+		key := common.ExtractJiraTicketNumber(link)
+		issue := entry.JiraIssue(link)
+		if issue == nil {
+			// Not looked up (e.g. on another Jira site), so it is left alone
+			continue
+		}
+
+		var done []string
+		switch {
+		case action.Transition == "":
+		case strings.EqualFold(issue.Status, action.Transition):
+			done = append(done, "already "+issue.Status)
+		default:
+			if err := u.jira.TransitionIssue(ctx, key, action.Transition); err != nil {
+				done = append(done, "⚠️ "+err.Error())
+			} else {
+				done = append(done, "moved to "+action.Transition)
+			}
+		}
+		if action.Comment {
+			if err := u.jira.AddComment(ctx, key, formatJiraComment(entry, stage)); err != nil {
+				done = append(done, "⚠️ "+err.Error())
+			} else {
+				done = append(done, "commented")
+			}
+		}
+		outcomes = append(outcomes, fmt.Sprintf("%s: %s", key, strings.Join(done, ", ")))
+	}
+	if len(outcomes) == 0 {
+		return nil
+	}
+
+	entry.AppendEvent(entity.ReviewEvent{
+		Type:   entity.ReviewEventJira,
+		Actor:  cfg.UserName,
+		At:     time.Now(),
+		Status: entry.CurrentStatus(),
+		Note:   strings.Join(outcomes, "\n"),
+	})
+	if err := u.historyRepo.Update(ctx, entry); err != nil {
+		return fmt.Errorf("update history: %w", err)
+	}
+	return nil
+}
+
+// formatJiraComment renders the comment added to the Jira issues of a request
+func formatJiraComment(entry *ReviewHistoryEntry, stage string) string {
+	var sb strings.Builder
+	if stage == config.StageCollab {
+		fmt.Fprintf(&sb, "Review request forwarded to head architect: %s (%s)\n", entry.Title, entry.Priority)
+	} else {
+		fmt.Fprintf(&sb, "Code review requested: %s (%s)\n", entry.Title, entry.Priority)
+	}
+	if len(entry.ReviewLinks) > 0 {
+		sb.WriteString("\nPull requests:\n")
+		for _, link := range entry.ReviewLinks {
+			fmt.Fprintf(&sb, "- %s\n", link)
+		}
+	}
+	fmt.Fprintf(&sb, "\nRequest ID: %s", entry.ID)
+	return sb.String()
+}
@@ -0,0 +1,210 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/yatbfi/cool/config"
+	"github.com/yatbfi/cool/internal/domain/entity"
+)
+
+// ReviewStatus represents a review lifecycle status (alias from entity)
+type ReviewStatus = entity.ReviewStatus
+
+// reviewTransitions lists the legal status changes of the review lifecycle. Merged and
+// closed are set by review sync from the pull request state, which may happen at any
+// open stage.
+var reviewTransitions = map[entity.ReviewStatus][]entity.ReviewStatus{
+	entity.ReviewStatusDraft: {
+		entity.ReviewStatusSubmitted,
+	},
+	entity.ReviewStatusSubmitted: {
+		entity.ReviewStatusChangesRequested,
+		entity.ReviewStatusTechLeadApproved,
+		entity.ReviewStatusWithdrawn,
+		entity.ReviewStatusMerged,
+		entity.ReviewStatusClosed,
+	},
+	entity.ReviewStatusChangesRequested: {
+		entity.ReviewStatusSubmitted,
+		entity.ReviewStatusTechLeadApproved,
+		entity.ReviewStatusWithdrawn,
+		entity.ReviewStatusMerged,
+		entity.ReviewStatusClosed,
+	},
+	entity.ReviewStatusTechLeadApproved: {
+		entity.ReviewStatusChangesRequested,
+		entity.ReviewStatusForwarded,
+		entity.ReviewStatusWithdrawn,
+		entity.ReviewStatusMerged,
+		entity.ReviewStatusClosed,
+	},
+	entity.ReviewStatusForwarded: {
+		entity.ReviewStatusChangesRequested,
+		entity.ReviewStatusArchitectApproved,
+		entity.ReviewStatusWithdrawn,
+		entity.ReviewStatusMerged,
+		entity.ReviewStatusClosed,
+	},
+	entity.ReviewStatusArchitectApproved: {
+		entity.ReviewStatusMerged,
+		entity.ReviewStatusClosed,
+		entity.ReviewStatusWithdrawn,
+	},
+}
+
+// CanTransition reports whether a review may move from one status to another
+func CanTransition(from, to ReviewStatus) bool {
+	return slices.Contains(reviewTransitions[from], to)
+}
+
+// ValidateForward checks whether an entry may be forwarded to the collaboration channel.
+// Forcing skips the tech lead approval requirement but never forwards an unsubmitted draft
+// or reopens a finished review.
+func ValidateForward(entry *ReviewHistoryEntry, force bool) error {
+	from := entry.CurrentStatus()
+	if CanTransition(from, entity.ReviewStatusForwarded) {
+		return nil
+	}
+	if from == entity.ReviewStatusDraft {
+		return fmt.Errorf("review %s is a draft that was never submitted; submit it with 'cool review request --resume %s' first", entry.ID, entry.ID)
+	}
+	if force && !from.IsTerminal() {
+		return nil
+	}
+
+	if from == entity.ReviewStatusSubmitted || from == entity.ReviewStatusChangesRequested {
+		return fmt.Errorf("review %s is not approved by tech lead yet (status: %s); run 'cool review approve %s' first or use --force", entry.ID, from, entry.ID)
+	}
+	return fmt.Errorf("review %s cannot be forwarded (status: %s)", entry.ID, from)
+}
+
+// ApproveReview records the next approval: tech lead approval first, architect approval once forwarded
+func (u *reviewUsecase) ApproveReview(ctx context.Context, historyID string, note string) (*ReviewHistoryEntry, error) {
+	entry, err := u.findEntry(ctx, historyID)
+	if err != nil {
+		return nil, fmt.Errorf("get history: %w", err)
+	}
+
+	to := entity.ReviewStatusTechLeadApproved
+	if entry.CurrentStatus() == entity.ReviewStatusForwarded {
+		to = entity.ReviewStatusArchitectApproved
+	}
+
+	return u.changeStatus(ctx, entry, to, entity.ReviewEventApproved, note)
+}
+
+// RejectReview sends a review back to the developer with requested changes
+func (u *reviewUsecase) RejectReview(ctx context.Context, historyID string, note string) (*ReviewHistoryEntry, error) {
+	entry, err := u.findEntry(ctx, historyID)
+	if err != nil {
+		return nil, fmt.Errorf("get history: %w", err)
+	}
+
+	return u.changeStatus(ctx, entry, entity.ReviewStatusChangesRequested, entity.ReviewEventRejected, note)
+}
+
+// WithdrawReview ends the lifecycle of a review that is no longer needed
+func (u *reviewUsecase) WithdrawReview(ctx context.Context, historyID string, note string) (*ReviewHistoryEntry, error) {
+	entry, err := u.findEntry(ctx, historyID)
+	if err != nil {
+		return nil, fmt.Errorf("get history: %w", err)
+	}
+
+	if err := applyTransition(entry, entity.ReviewStatusWithdrawn, entity.ReviewEventWithdrawn, note); err != nil {
+		return nil, err
+	}
+
+	// Persist the withdrawal together with its notifications, so a failure stores neither
+	if err := u.notifyWithdrawal(ctx, entry, note); err != nil {
+		return nil, fmt.Errorf("notify withdrawal: %w", err)
+	}
+	return entry, nil
+}
+
+// notifyWithdrawal stores the withdrawal and replies in the request threads so reviewers
+// stop working on it
+func (u *reviewUsecase) notifyWithdrawal(ctx context.Context, entry *ReviewHistoryEntry, note string) error {
+	message := formatWithdrawalMessage(entry, note)
+	entry.Events[len(entry.Events)-1].Message = message
+	notification := newTextNotification(NotificationWithdrawal, entry, message)
+
+	var notifications []stageNotification
+	if hasStageChannels(entry, config.StageReview) {
+		notifications = append(notifications, stageNotification{config.StageReview, notification})
+	}
+	if entry.SubmittedToCollab && hasStageChannels(entry, config.StageCollab) {
+		notifications = append(notifications, stageNotification{config.StageCollab, notification})
+	}
+
+	return u.publish(ctx, entry, u.historyRepo.Update, notifications...)
+}
+
+// changeStatus validates and persists a status transition together with its timeline event
+func (u *reviewUsecase) changeStatus(ctx context.Context, entry *ReviewHistoryEntry, to ReviewStatus, eventType entity.ReviewEventType, note string) (*ReviewHistoryEntry, error) {
+	if err := applyTransition(entry, to, eventType, note); err != nil {
+		return nil, err
+	}
+
+	if err := u.historyRepo.Update(ctx, entry); err != nil {
+		return nil, fmt.Errorf("update history: %w", err)
+	}
+
+	return entry, nil
+}
+
+// applyTransition validates a status transition and applies it with its timeline event,
+// without persisting the entry
+func applyTransition(entry *ReviewHistoryEntry, to ReviewStatus, eventType entity.ReviewEventType, note string) error {
+	from := entry.CurrentStatus()
+	if !CanTransition(from, to) {
+		return fmt.Errorf("review %s cannot move from %s to %s", entry.ID, from, to)
+	}
+
+	now := time.Now()
+	applyStatus(entry, to, now)
+	if note != "" {
+		entry.Notes = note
+	}
+	entry.AppendEvent(entity.ReviewEvent{
+		Type:   eventType,
+		Actor:  config.GetConfig().UserName,
+		At:     now,
+		Status: to,
+		Note:   note,
+	})
+	return nil
+}
+
+// applyStatus sets the status and keeps the legacy approval flags in sync
+func applyStatus(entry *ReviewHistoryEntry, status ReviewStatus, now time.Time) {
+	entry.Status = status
+	entry.UpdatedAt = &now
+
+	switch status {
+	case entity.ReviewStatusTechLeadApproved:
+		entry.ApprovedByTechLead = true
+	case entity.ReviewStatusArchitectApproved:
+		entry.ApprovedByArchitect = true
+	case entity.ReviewStatusChangesRequested:
+		entry.ApprovedByTechLead = false
+		entry.ApprovedByArchitect = false
+	}
+}
+
+func formatWithdrawalMessage(entry *entity.ReviewHistoryEntry, note string) string {
+	cfg := config.GetConfig()
+
+	msg := "↩️ *Review Request Withdrawn*\n\n"
+	msg += fmt.Sprintf("*Title:* %s\n", entry.Title)
+	msg += fmt.Sprintf("*Withdrawn by:* %s (%s)\n", cfg.UserName, cfg.UserEmail)
+	if note != "" {
+		msg += fmt.Sprintf("*Reason:* %s\n", note)
+	}
+	msg += "\nNo further review is needed.\n\n"
+	msg += fmt.Sprintf("*Request ID:* `%s`\n", entry.ID)
+
+	return msg
+}
@@ -0,0 +1,32 @@
+package usecase
+
+import (
+	"testing"
+
+	"github.com/yatbfi/cool/internal/domain/entity"
+)
+
+func TestValidateForward(t *testing.T) {
+	tests := []struct {
+		status  entity.ReviewStatus
+		force   bool
+		wantErr bool
+	}{
+		{status: entity.ReviewStatusTechLeadApproved},
+		{status: entity.ReviewStatusSubmitted, wantErr: true},
+		{status: entity.ReviewStatusSubmitted, force: true},
+		{status: entity.ReviewStatusChangesRequested, force: true},
+		{status: entity.ReviewStatusDraft, wantErr: true},
+		{status: entity.ReviewStatusDraft, force: true, wantErr: true},
+		{status: entity.ReviewStatusMerged, force: true, wantErr: true},
+		{status: entity.ReviewStatusWithdrawn, force: true, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		entry := &ReviewHistoryEntry{ID: "rev-1", Status: tt.status}
+		err := ValidateForward(entry, tt.force)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("ValidateForward(%s, force=%v) error = %v, wantErr %v", tt.status, tt.force, err, tt.wantErr)
+		}
+	}
+}
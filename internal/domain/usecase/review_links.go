@@ -0,0 +1,153 @@
+package usecase
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"regexp"
+	"strings"
+
+	"github.com/yatbfi/cool/config"
+	"github.com/yatbfi/cool/internal/pkg/common"
+)
+
+var (
+	// prShorthandPattern matches GitHub shorthand like "owner/repo#42"
+	prShorthandPattern = regexp.MustCompile(`^([\w.-]+)/([\w.-]+)#(\d+)$`)
+	// jiraKeyPattern matches issue keys like "ABC-123"
+	jiraKeyPattern = regexp.MustCompile(`^[A-Za-z][A-Za-z0-9_]*-\d+$`)
+	digitsPattern  = regexp.MustCompile(`^\d+$`)
+)
+
+// NormalizeLinks validates the pull request and Jira links of the request and replaces
+// them with their canonical form, without duplicates
+func (r *ReviewRequest) NormalizeLinks() error {
+	reviewLinks, prErr := normalizeLinks(r.ReviewLinks, NormalizePRLink)
+	jiraLinks, jiraErr := normalizeLinks(r.JiraLinks, NormalizeJiraLink)
+	if err := errors.Join(prErr, jiraErr); err != nil {
+		return err
+	}
+
+	r.ReviewLinks = reviewLinks
+	r.JiraLinks = jiraLinks
+	return nil
+}
+
+// NormalizePRLink validates a pull request link on a known code host and returns it without
+// query, fragment or trailing path (e.g. "/files"). "owner/repo#42" expands to GitHub.
+func NormalizePRLink(link string) (string, error) {
+	link = strings.TrimSpace(link)
+	if m := prShorthandPattern.FindStringSubmatch(link); m != nil {
+		return fmt.Sprintf("https://github.com/%s/%s/pull/%s", m[1], m[2], m[3]), nil
+	}
+
+	u, err := parseLink(link)
+	if err != nil {
+		return "", fmt.Errorf("invalid pull request link %q: expected a URL or owner/repo#number", link)
+	}
+
+	hosts := config.GetConfig().KnownPRHosts()
+	if !isKnownHost(u.Hostname(), hosts) {
+		return "", fmt.Errorf("invalid pull request link %q: %s is not a known code host (add it to pr_hosts in ~/.cool-cli/config.json)", link, u.Hostname())
+	}
+
+	number, _, _ := strings.Cut(common.ExtractPRNumber(u.Path), "/")
+	if !digitsPattern.MatchString(number) || common.ExtractRepository(u.String()) == "" {
+		return "", fmt.Errorf("invalid pull request link %q: no pull request number found", link)
+	}
+
+	for _, segment := range common.PRPathSegments {
+		if i := strings.Index(u.Path, segment); i >= 0 {
+			u.Path = u.Path[:i+len(segment)] + number
+			break
+		}
+	}
+	u.RawQuery = ""
+	u.Fragment = ""
+	return u.String(), nil
+}
+
+// NormalizeJiraLink validates a Jira issue link and returns it without query or fragment.
+// A bare key like "ABC-123" expands with the configured jira_base_url.
+func NormalizeJiraLink(link string) (string, error) {
+	link = strings.TrimSpace(link)
+	if jiraKeyPattern.MatchString(link) {
+		browseURL, ok := config.GetConfig().JiraBrowseURL(strings.ToUpper(link))
+		if !ok {
+			return "", fmt.Errorf("invalid Jira link %q: set jira_base_url in ~/.cool-cli/config.json to use bare issue keys", link)
+		}
+		return browseURL, nil
+	}
+
+	u, err := parseLink(link)
+	if err != nil {
+		return "", fmt.Errorf("invalid Jira link %q: expected a URL or issue key like ABC-123", link)
+	}
+
+	key := common.ExtractJiraTicketNumber(u.Path)
+	if !jiraKeyPattern.MatchString(key) {
+		return "", fmt.Errorf("invalid Jira link %q: no issue key found (expected .../browse/ABC-123)", link)
+	}
+
+	base, _, _ := strings.Cut(u.Path, "/browse/")
+	u.Path = base + "/browse/" + strings.ToUpper(key)
+	u.RawQuery = ""
+	u.Fragment = ""
+	return u.String(), nil
+}
+
+// normalizeLinks normalizes every link, dropping empty lines and duplicates
+func normalizeLinks(links []string, normalize func(string) (string, error)) ([]string, error) {
+	var result []string
+	var errs []error
+	seen := map[string]bool{}
+	for _, link := range links {
+		if strings.TrimSpace(link) == "" {
+			continue
+		}
+
+		normalized, err := normalize(link)
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		if !seen[normalized] {
+			seen[normalized] = true
+			result = append(result, normalized)
+		}
+	}
+	return result, errors.Join(errs...)
+}
+
+// parseLink parses an http(s) URL, adding https:// when the scheme is missing
+func parseLink(link string) (*url.URL, error) {
+	schemeless := !strings.Contains(link, "://")
+	if schemeless {
+		link = "https://" + link
+	}
+
+	u, err := url.Parse(link)
+	if err != nil {
+		return nil, err
+	}
+	if schemeless && !strings.Contains(u.Hostname(), ".") {
+		return nil, fmt.Errorf("not a web link")
+	}
+	if (u.Scheme != "http" && u.Scheme != "https") || u.Hostname() == "" || strings.ContainsAny(u.Host, " \t") {
+		return nil, fmt.Errorf("not a web link")
+	}
+	u.Scheme = strings.ToLower(u.Scheme)
+	u.Host = strings.ToLower(u.Host)
+	return u, nil
+}
+
+// isKnownHost reports whether host is one of hosts or a subdomain of one
+func isKnownHost(host string, hosts []string) bool {
+	host = strings.ToLower(host)
+	for _, known := range hosts {
+		if host == known || strings.HasSuffix(host, "."+known) {
+			return true
+		}
+	}
+	return false
+}
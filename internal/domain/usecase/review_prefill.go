@@ -0,0 +1,148 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"regexp"
+	"strings"
+
+	"github.com/yatbfi/cool/config"
+	"github.com/yatbfi/cool/internal/pkg/common"
+)
+
+var (
+	// branchJiraKeyPattern finds an upper-case issue key anywhere in a branch name
+	branchJiraKeyPattern = regexp.MustCompile(`[A-Z][A-Z0-9]+-\d+`)
+	// branchSegmentKeyPattern finds a lower-case key starting a branch segment, e.g. "feature/abc-123-foo"
+	branchSegmentKeyPattern = regexp.MustCompile(`(?:^|/)([a-z][a-z0-9]+-\d+)(?:[-_/]|$)`)
+	// mergeRefPattern matches the upstream of a checked-out pull or merge request
+	mergeRefPattern = regexp.MustCompile(`^refs/(pull|merge-requests)/(\d+)/head$`)
+)
+
+// GitPrefill is a review request pre-filled from the current git branch
+type GitPrefill struct {
+	Branch     string
+	BaseBranch string
+	Request    ReviewRequest
+
+	// JiraKey is the issue key found in the branch name, also when it could not be
+	// expanded to a link because jira_base_url is not configured
+	JiraKey string
+	// NewPullRequestURL opens a pull request for the branch when none could be linked
+	NewPullRequestURL string
+}
+
+// PrefillFromGit derives a title, Jira link, PR link and description from a git branch.
+// The open pull request of the branch is looked up with codeHost when it is not nil.
+func PrefillFromGit(ctx context.Context, info *common.GitBranchInfo, codeHost CodeHost) *GitPrefill {
+	prefill := &GitPrefill{
+		Branch:     info.Branch,
+		BaseBranch: info.BaseBranch,
+		Request: ReviewRequest{
+			Title:       prefillTitle(info),
+			Description: prefillDescription(info),
+			Priority:    DefaultPriority,
+		},
+	}
+
+	prefill.JiraKey = branchJiraKey(info.Branch)
+	if prefill.JiraKey != "" {
+		if link, err := NormalizeJiraLink(prefill.JiraKey); err == nil {
+			prefill.Request.JiraLinks = []string{link}
+		}
+	}
+
+	prLink, newPRURL := branchPullRequest(ctx, info, codeHost)
+	if prLink != "" {
+		prefill.Request.ReviewLinks = []string{prLink}
+	}
+	prefill.NewPullRequestURL = newPRURL
+
+	return prefill
+}
+
+// prefillTitle uses the first commit subject of the branch, or the branch name
+func prefillTitle(info *common.GitBranchInfo) string {
+	if len(info.Commits) > 0 {
+		return info.Commits[0].Subject
+	}
+
+	name := info.Branch[strings.LastIndex(info.Branch, "/")+1:]
+	if key := branchJiraKey(name); key != "" {
+		name = strings.TrimLeft(name[len(key):], "-_")
+	}
+	name = strings.TrimSpace(strings.NewReplacer("-", " ", "_", " ").Replace(name))
+	if name == "" {
+		return ""
+	}
+	return strings.ToUpper(name[:1]) + name[1:]
+}
+
+// prefillDescription lists the commits of the branch
+func prefillDescription(info *common.GitBranchInfo) string {
+	if len(info.Commits) == 0 {
+		return ""
+	}
+
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "Commits since %s:\n", info.BaseBranch)
+	for _, commit := range info.Commits {
+		fmt.Fprintf(&sb, "- %s (%s)\n", commit.Subject, commit.Hash)
+	}
+	return strings.TrimSpace(sb.String())
+}
+
+// branchJiraKey returns the Jira issue key in a branch name, e.g. "ABC-123" for "feature/ABC-123-foo"
+func branchJiraKey(branch string) string {
+	if key := branchJiraKeyPattern.FindString(branch); key != "" {
+		return key
+	}
+	if m := branchSegmentKeyPattern.FindStringSubmatch(branch); m != nil {
+		return strings.ToUpper(m[1])
+	}
+	return ""
+}
+
+// branchPullRequest returns the pull request link of a branch checked out from a pull or
+// merge request (e.g. with `gh pr checkout`) or of the open pull request found on the code
+// host for the branch, otherwise the URL to open one
+func branchPullRequest(ctx context.Context, info *common.GitBranchInfo, codeHost CodeHost) (link, newURL string) {
+	host, path, ok := common.GitRemoteRepository(info.RemoteURL)
+	if !ok {
+		return "", ""
+	}
+	base := "https://" + host + "/" + path
+
+	if m := mergeRefPattern.FindStringSubmatch(info.MergeRef); m != nil {
+		link = base + "/pull/" + m[2]
+		if m[1] == "merge-requests" {
+			link = base + "/-/merge_requests/" + m[2]
+		}
+		if normalized, err := NormalizePRLink(link); err == nil {
+			return normalized, ""
+		}
+		return "", ""
+	}
+
+	// Lookup failures (no token, rate limit) only cost the suggestion
+	if codeHost != nil {
+		if link, err := codeHost.FindOpenPullRequest(ctx, host, path, info.Branch); err == nil && link != "" {
+			if normalized, err := NormalizePRLink(link); err == nil {
+				return normalized, ""
+			}
+		}
+	}
+
+	if !isKnownHost(host, config.GetConfig().KnownPRHosts()) {
+		return "", ""
+	}
+	switch {
+	case strings.Contains(host, "gitlab"):
+		return "", base + "/-/merge_requests/new?" + url.Values{"merge_request[source_branch]": {info.Branch}}.Encode()
+	case strings.Contains(host, "bitbucket"):
+		return "", base + "/pull-requests/new?" + url.Values{"source": {info.Branch}}.Encode()
+	default:
+		return "", base + "/pull/new/" + info.Branch
+	}
+}
@@ -0,0 +1,218 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/yatbfi/cool/config"
+	"github.com/yatbfi/cool/internal/domain/entity"
+	"github.com/yatbfi/cool/internal/pkg/common"
+)
+
+// Reminder outcomes
+const (
+	ReminderSent     = "sent"
+	ReminderDryRun   = "dry-run"
+	ReminderCooldown = "cooldown"
+)
+
+// reminderStages maps the statuses waiting on a reviewer to the stage that gets reminded
+var reminderStages = map[ReviewStatus]string{
+	entity.ReviewStatusSubmitted: config.StageReview,
+	entity.ReviewStatusForwarded: config.StageCollab,
+}
+
+// OverdueReview is a review request waiting longer than the SLA of its priority
+type OverdueReview struct {
+	Entry        *ReviewHistoryEntry
+	Stage        string // stage whose channels are reminded
+	WaitingSince time.Time
+	Waiting      time.Duration // measured like the SLA (weekdays only for business-day SLAs)
+	SLA          config.SLA
+	LastReminder *time.Time
+	Outcome      string // set by RemindOverdueReviews
+}
+
+// RemindOptions controls RemindOverdueReviews
+type RemindOptions struct {
+	DryRun bool
+	// Cooldown overrides the configured reminder cooldown when set; zero disables it
+	Cooldown *time.Duration
+}
+
+// FindOverdueReviews returns open requests waiting on a reviewer for longer than their
+// priority SLA, longest waiting first
+func (u *reviewUsecase) FindOverdueReviews(ctx context.Context, now time.Time) ([]*OverdueReview, error) {
+	cfg := config.GetConfig()
+
+	entries, err := u.historyRepo.FindAll(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("get histories: %w", err)
+	}
+
+	var overdue []*OverdueReview
+	for _, entry := range entries {
+		stage, ok := reminderStages[entry.CurrentStatus()]
+		if !ok {
+			continue
+		}
+
+		sla, ok, err := cfg.ReviewSLAFor(NormalizePriority(entry.Priority))
+		if err != nil {
+			return nil, err
+		}
+		if !ok {
+			continue
+		}
+
+		since := waitingSince(entry)
+		waiting := now.Sub(since)
+		if sla.BusinessDays {
+			waiting = businessDuration(since, now)
+		}
+		if waiting < sla.Duration {
+			continue
+		}
+
+		overdue = append(overdue, &OverdueReview{
+			Entry:        entry,
+			Stage:        stage,
+			WaitingSince: since,
+			Waiting:      waiting,
+			SLA:          sla,
+			LastReminder: lastReminder(entry),
+		})
+	}
+
+	sort.Slice(overdue, func(i, j int) bool {
+		return overdue[i].WaitingSince.Before(overdue[j].WaitingSince)
+	})
+	return overdue, nil
+}
+
+// RemindOverdueReviews posts a reminder in the request thread of every overdue request
+// that was not reminded within the cooldown
+func (u *reviewUsecase) RemindOverdueReviews(ctx context.Context, opts RemindOptions) ([]*OverdueReview, error) {
+	cfg := config.GetConfig()
+
+	var cooldown time.Duration
+	if opts.Cooldown != nil {
+		cooldown = *opts.Cooldown
+	} else {
+		var err error
+		if cooldown, err = cfg.ReminderCooldownDuration(); err != nil {
+			return nil, err
+		}
+	}
+	if cooldown < 0 {
+		return nil, fmt.Errorf("reminder cooldown must not be negative, got %s", cooldown)
+	}
+
+	now := time.Now()
+	overdue, err := u.FindOverdueReviews(ctx, now)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, o := range overdue {
+		switch {
+		case o.LastReminder != nil && now.Sub(*o.LastReminder) < cooldown:
+			o.Outcome = ReminderCooldown
+			continue
+		case opts.DryRun:
+			o.Outcome = ReminderDryRun
+			continue
+		}
+
+		message := formatReminderMessage(o)
+		o.Entry.AppendEvent(entity.ReviewEvent{
+			Type:    entity.ReviewEventReminded,
+			Actor:   cfg.UserName,
+			At:      now,
+			Status:  o.Entry.CurrentStatus(),
+			Note:    fmt.Sprintf("waiting %s (SLA %s)", common.FormatDuration(o.Waiting), o.SLA),
+			Message: message,
+		})
+
+		notification := newTextNotification(NotificationReminder, o.Entry, message)
+		notification.Mentions = reviewerMentions(o.Entry, o.Stage)
+		if err := u.publish(ctx, o.Entry, u.historyRepo.Update, stageNotification{o.Stage, notification}); err != nil {
+			return overdue, fmt.Errorf("remind %s: %w", o.Entry.ID, err)
+		}
+		o.Outcome = ReminderSent
+	}
+
+	return overdue, nil
+}
+
+// waitingSince returns when the request entered its current status. Side events like
+// reminders and Jira updates are recorded within a status and do not restart the clock.
+func waitingSince(entry *ReviewHistoryEntry) time.Time {
+	var events []entity.ReviewEvent
+	for _, event := range entry.Timeline() {
+		if event.Type.ChangesStage() {
+			events = append(events, event)
+		}
+	}
+	status := entry.CurrentStatus()
+
+	i := len(events) - 1
+	if i < 0 || events[i].Status != status {
+		return entry.SubmittedAt
+	}
+	for i > 0 && events[i-1].Status == status {
+		i--
+	}
+	return events[i].At
+}
+
+// lastReminder returns when the request was last reminded, if ever
+func lastReminder(entry *ReviewHistoryEntry) *time.Time {
+	for i := len(entry.Events) - 1; i >= 0; i-- {
+		if entry.Events[i].Type == entity.ReviewEventReminded {
+			return &entry.Events[i].At
+		}
+	}
+	return nil
+}
+
+// businessDuration returns the part of [from, to) falling on Monday to Friday (local time)
+func businessDuration(from, to time.Time) time.Duration {
+	var total time.Duration
+	for from.Before(to) {
+		y, m, d := from.Date()
+		next := time.Date(y, m, d+1, 0, 0, 0, 0, from.Location())
+		if next.After(to) {
+			next = to
+		}
+		if wd := from.Weekday(); wd != time.Saturday && wd != time.Sunday {
+			total += next.Sub(from)
+		}
+		from = next
+	}
+	return total
+}
+
+func formatReminderMessage(o *OverdueReview) string {
+	entry := o.Entry
+
+	msg := "⏰ *Review Reminder*\n\n"
+	msg += fmt.Sprintf("*Title:* %s\n", entry.Title)
+	msg += fmt.Sprintf("*Priority:* %s\n", entry.Priority)
+	msg += fmt.Sprintf("*Waiting for review:* %s (SLA %s)\n", common.FormatDuration(o.Waiting), o.SLA)
+	msg += fmt.Sprintf("*Submitted by:* %s (%s)\n\n", entry.SubmittedBy, entry.SubmittedByEmail)
+
+	if len(entry.ReviewLinks) > 0 {
+		msg += "*Review Links:*\n"
+		for _, link := range entry.ReviewLinks {
+			msg += fmt.Sprintf("• %s\n", link)
+		}
+		msg += "\n"
+	}
+
+	msg += fmt.Sprintf("*Request ID:* `%s`\n", entry.ID)
+
+	return msg
+}
@@ -0,0 +1,279 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sort"
+	"time"
+
+	"github.com/yatbfi/cool/internal/domain/entity"
+	"github.com/yatbfi/cool/internal/pkg/common"
+)
+
+// statsTopLimit is the number of Jira projects and repositories reported
+const statsTopLimit = 5
+
+// StatsOptions limits the requests included in ReviewStats by submission time
+type StatsOptions struct {
+	Since *time.Time
+	Until *time.Time
+}
+
+// ReviewStats summarizes review turnaround. Durations are reported in seconds.
+type ReviewStats struct {
+	Since *time.Time `json:"since,omitempty"`
+	Until *time.Time `json:"until,omitempty"`
+	Total int        `json:"total"`
+
+	Weekly          []WeeklyCount        `json:"weekly"`
+	Turnaround      []PriorityTurnaround `json:"turnaround"`
+	Backlog         BacklogStats         `json:"backlog"`
+	TopJiraProjects []NamedCount         `json:"top_jira_projects"`
+	TopRepositories []NamedCount         `json:"top_repositories"`
+}
+
+// WeeklyCount is the number of requests submitted in an ISO week
+type WeeklyCount struct {
+	Week      string    `json:"week"` // e.g. "2025-W46"
+	WeekStart time.Time `json:"week_start"`
+	Count     int       `json:"count"`
+}
+
+// PriorityTurnaround is the time from submission to collaboration forward for a priority
+type PriorityTurnaround struct {
+	Priority      string `json:"priority"` // "All" for every priority
+	Count         int    `json:"count"`
+	MedianSeconds int64  `json:"median_seconds"`
+	P90Seconds    int64  `json:"p90_seconds"`
+}
+
+// BacklogStats describes requests not forwarded to collaboration yet
+type BacklogStats struct {
+	Count         int          `json:"count"`
+	MedianSeconds int64        `json:"median_age_seconds"`
+	OldestSeconds int64        `json:"oldest_age_seconds"`
+	Oldest        string       `json:"oldest_id,omitempty"`
+	Buckets       []NamedCount `json:"buckets"`
+}
+
+// NamedCount is a labelled count
+type NamedCount struct {
+	Name  string `json:"name"`
+	Count int    `json:"count"`
+}
+
+// backlogBuckets are the age ranges the backlog is grouped into
+var backlogBuckets = []struct {
+	name string
+	max  time.Duration
+}{
+	{"< 1d", 24 * time.Hour},
+	{"1-3d", 3 * 24 * time.Hour},
+	{"3-7d", 7 * 24 * time.Hour},
+	{"> 7d", math.MaxInt64},
+}
+
+// GetStats computes turnaround statistics for submitted requests
+func (u *reviewUsecase) GetStats(ctx context.Context, opts StatsOptions) (*ReviewStats, error) {
+	entries, err := u.GetHistories(ctx, HistoryFilterAll)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	if opts.Until != nil && opts.Until.Before(now) {
+		now = *opts.Until
+	}
+
+	selected := filterSubmittedBetween(entries, opts.Since, opts.Until)
+
+	return &ReviewStats{
+		Since:           opts.Since,
+		Until:           opts.Until,
+		Total:           len(selected),
+		Weekly:          weeklyCounts(selected),
+		Turnaround:      turnaroundByPriority(selected),
+		Backlog:         backlogStats(selected, now),
+		TopJiraProjects: topCounts(selected, func(e *ReviewHistoryEntry) []string { return mapLinks(e.JiraLinks, common.ExtractJiraProject) }),
+		TopRepositories: topCounts(selected, func(e *ReviewHistoryEntry) []string { return mapLinks(e.ReviewLinks, common.ExtractRepository) }),
+	}, nil
+}
+
+// weeklyCounts counts requests per ISO week, including empty weeks in between
+func weeklyCounts(entries []*ReviewHistoryEntry) []WeeklyCount {
+	if len(entries) == 0 {
+		return []WeeklyCount{}
+	}
+
+	counts := map[time.Time]int{}
+	var first, last time.Time
+	for _, entry := range entries {
+		week := weekStart(entry.SubmittedAt)
+		counts[week]++
+		if first.IsZero() || week.Before(first) {
+			first = week
+		}
+		if week.After(last) {
+			last = week
+		}
+	}
+
+	var weekly []WeeklyCount
+	for week := first; !week.After(last); week = week.AddDate(0, 0, 7) {
+		year, number := week.ISOWeek()
+		weekly = append(weekly, WeeklyCount{
+			Week:      fmt.Sprintf("%d-W%02d", year, number),
+			WeekStart: week,
+			Count:     counts[week],
+		})
+	}
+	return weekly
+}
+
+// weekStart returns midnight of the Monday starting the week of t
+func weekStart(t time.Time) time.Time {
+	offset := (int(t.Weekday()) + 6) % 7 // days since Monday
+	y, m, d := t.Date()
+	return time.Date(y, m, d-offset, 0, 0, 0, 0, t.Location())
+}
+
+// turnaroundByPriority returns submit-to-forward percentiles per priority, followed by all priorities
+func turnaroundByPriority(entries []*ReviewHistoryEntry) []PriorityTurnaround {
+	durations := map[string][]time.Duration{}
+	var all []time.Duration
+	for _, entry := range entries {
+		if entry.SubmittedToCollabAt == nil {
+			continue
+		}
+		d := entry.SubmittedToCollabAt.Sub(entry.SubmittedAt)
+		priority := NormalizePriority(entry.Priority)
+		durations[priority] = append(durations[priority], d)
+		all = append(all, d)
+	}
+
+	priorities := make([]string, 0, len(durations))
+	for priority := range durations {
+		priorities = append(priorities, priority)
+	}
+	sort.Strings(priorities)
+
+	turnaround := []PriorityTurnaround{}
+	for _, priority := range priorities {
+		turnaround = append(turnaround, newPriorityTurnaround(priority, durations[priority]))
+	}
+	if len(all) > 0 {
+		turnaround = append(turnaround, newPriorityTurnaround("All", all))
+	}
+	return turnaround
+}
+
+func newPriorityTurnaround(priority string, durations []time.Duration) PriorityTurnaround {
+	return PriorityTurnaround{
+		Priority:      priority,
+		Count:         len(durations),
+		MedianSeconds: int64(percentile(durations, 0.5).Seconds()),
+		P90Seconds:    int64(percentile(durations, 0.9).Seconds()),
+	}
+}
+
+// backlogStats describes the age of requests still waiting to be forwarded at now, which
+// is the end of the stats range
+func backlogStats(entries []*ReviewHistoryEntry, now time.Time) BacklogStats {
+	stats := BacklogStats{Buckets: make([]NamedCount, len(backlogBuckets))}
+	for i, bucket := range backlogBuckets {
+		stats.Buckets[i].Name = bucket.name
+	}
+
+	var ages []time.Duration
+	for _, entry := range entries {
+		// Entries saved before forwarding was timestamped count as forwarded
+		forwarded := entry.SubmittedToCollab && (entry.SubmittedToCollabAt == nil || !entry.SubmittedToCollabAt.After(now))
+		status := statusAt(entry, now)
+		if forwarded || status.IsTerminal() || status == entity.ReviewStatusDraft {
+			continue
+		}
+
+		age := now.Sub(entry.SubmittedAt)
+		ages = append(ages, age)
+		if int64(age.Seconds()) >= stats.OldestSeconds {
+			stats.OldestSeconds = int64(age.Seconds())
+			stats.Oldest = entry.ID
+		}
+		for i, bucket := range backlogBuckets {
+			if age < bucket.max {
+				stats.Buckets[i].Count++
+				break
+			}
+		}
+	}
+
+	stats.Count = len(ages)
+	stats.MedianSeconds = int64(percentile(ages, 0.5).Seconds())
+	return stats
+}
+
+// statusAt returns the status an entry had at t, from the last stage event up to t
+func statusAt(entry *ReviewHistoryEntry, t time.Time) ReviewStatus {
+	status := ReviewStatus("")
+	for _, event := range entry.Timeline() {
+		if event.Type.ChangesStage() && event.Status != "" && !event.At.After(t) {
+			status = event.Status
+		}
+	}
+	if status == "" {
+		return entry.CurrentStatus()
+	}
+	return status
+}
+
+// topCounts returns the most frequent keys, counting each key once per request
+func topCounts(entries []*ReviewHistoryEntry, keys func(*ReviewHistoryEntry) []string) []NamedCount {
+	counts := map[string]int{}
+	for _, entry := range entries {
+		seen := map[string]bool{}
+		for _, key := range keys(entry) {
+			if key != "" && !seen[key] {
+				seen[key] = true
+				counts[key]++
+			}
+		}
+	}
+
+	top := make([]NamedCount, 0, len(counts))
+	for name, count := range counts {
+		top = append(top, NamedCount{Name: name, Count: count})
+	}
+	sort.Slice(top, func(i, j int) bool {
+		if top[i].Count != top[j].Count {
+			return top[i].Count > top[j].Count
+		}
+		return top[i].Name < top[j].Name
+	})
+
+	if len(top) > statsTopLimit {
+		top = top[:statsTopLimit]
+	}
+	return top
+}
+
+func mapLinks(links []string, extract func(string) string) []string {
+	keys := make([]string, 0, len(links))
+	for _, link := range links {
+		keys = append(keys, extract(link))
+	}
+	return keys
+}
+
+// percentile returns the nearest-rank percentile (0 < p <= 1) of the durations
+func percentile(durations []time.Duration, p float64) time.Duration {
+	if len(durations) == 0 {
+		return 0
+	}
+
+	sorted := append([]time.Duration(nil), durations...)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i] < sorted[j] })
+
+	rank := int(math.Ceil(p*float64(len(sorted)))) - 1
+	return sorted[max(rank, 0)]
+}
@@ -0,0 +1,64 @@
+package usecase
+
+import (
+	"testing"
+	"time"
+
+	"github.com/yatbfi/cool/internal/domain/entity"
+)
+
+func TestBacklogStatsAsOfUntil(t *testing.T) {
+	until := time.Date(2025, 3, 10, 12, 0, 0, 0, time.UTC)
+	day := 24 * time.Hour
+	at := func(d time.Duration) *time.Time {
+		t := until.Add(d)
+		return &t
+	}
+
+	entries := []*ReviewHistoryEntry{
+		// Forwarded after until: still waiting at until
+		{
+			ID:                  "forwarded-later",
+			Status:              entity.ReviewStatusForwarded,
+			SubmittedAt:         until.Add(-2 * day),
+			SubmittedToCollab:   true,
+			SubmittedToCollabAt: at(day),
+		},
+		// Forwarded before until
+		{
+			ID:                  "forwarded-before",
+			Status:              entity.ReviewStatusForwarded,
+			SubmittedAt:         until.Add(-3 * day),
+			SubmittedToCollab:   true,
+			SubmittedToCollabAt: at(-day),
+		},
+		// Withdrawn after until: still waiting at until
+		{
+			ID:          "withdrawn-later",
+			Status:      entity.ReviewStatusWithdrawn,
+			SubmittedAt: until.Add(-5 * day),
+			Events: []entity.ReviewEvent{
+				{Type: entity.ReviewEventSubmitted, At: until.Add(-5 * day), Status: entity.ReviewStatusSubmitted},
+				{Type: entity.ReviewEventWithdrawn, At: until.Add(2 * day), Status: entity.ReviewStatusWithdrawn},
+			},
+		},
+		// Withdrawn before until
+		{
+			ID:          "withdrawn-before",
+			Status:      entity.ReviewStatusWithdrawn,
+			SubmittedAt: until.Add(-5 * day),
+			Events: []entity.ReviewEvent{
+				{Type: entity.ReviewEventSubmitted, At: until.Add(-5 * day), Status: entity.ReviewStatusSubmitted},
+				{Type: entity.ReviewEventWithdrawn, At: until.Add(-day), Status: entity.ReviewStatusWithdrawn},
+			},
+		},
+	}
+
+	stats := backlogStats(entries, until)
+	if stats.Count != 2 {
+		t.Errorf("Count = %d, want 2", stats.Count)
+	}
+	if stats.Oldest != "withdrawn-later" {
+		t.Errorf("Oldest = %q, want %q", stats.Oldest, "withdrawn-later")
+	}
+}
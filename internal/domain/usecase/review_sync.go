@@ -0,0 +1,214 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/yatbfi/cool/config"
+	"github.com/yatbfi/cool/internal/domain/entity"
+)
+
+// Sync outcomes of a review request
+const (
+	SyncMerged     = "merged"      // all pull requests are merged or closed, at least one merged
+	SyncClosed     = "closed"      // all pull requests are closed without merging
+	SyncUpdated    = "updated"     // pull request details changed, the request is still open
+	SyncUnchanged  = "unchanged"   // nothing changed on the code host
+	SyncSkipped    = "skipped"     // no pull request could be looked up
+	SyncNotChecked = "not-checked" // not reached because the rate limit was exceeded
+)
+
+// SyncOptions controls a review sync
+type SyncOptions struct {
+	DryRun bool // report what would change without saving
+}
+
+// SyncResult reports what a review sync found for every open request with pull requests
+type SyncResult struct {
+	Reviews []*SyncedReview
+	// RateLimitReset is when the sync may be run again after hitting the code host rate
+	// limit, zero when every request was checked
+	RateLimitReset time.Time
+}
+
+// Count returns the number of reviews with the given outcome
+func (r *SyncResult) Count(outcome string) int {
+	n := 0
+	for _, review := range r.Reviews {
+		if review.Outcome == outcome {
+			n++
+		}
+	}
+	return n
+}
+
+// SyncedReview is the sync outcome of a single review request
+type SyncedReview struct {
+	Entry        *ReviewHistoryEntry
+	Outcome      string
+	From         ReviewStatus
+	To           ReviewStatus // equals From unless the request was merged or closed
+	PullRequests []PullRequestChange
+	Reason       string // why the request was skipped
+}
+
+// PullRequestChange compares the stored and current details of a pull request
+type PullRequestChange struct {
+	Link string
+	Old  *PullRequestInfo // nil when never fetched before
+	New  *PullRequestInfo // nil when the lookup failed
+	Err  error
+}
+
+// Changed reports whether the state, draft flag or CI status of the pull request changed
+func (c PullRequestChange) Changed() bool {
+	switch {
+	case c.New == nil:
+		return false
+	case c.Old == nil:
+		return true
+	default:
+		return c.Old.State != c.New.State || c.Old.Draft != c.New.Draft || c.Old.CIStatus != c.New.CIStatus
+	}
+}
+
+// SyncReviews asks the code host for the state of every pull request of the open review
+// requests. A request whose pull requests are all merged or closed is marked merged (or
+// closed when none was merged) when the lifecycle allows it from its stage, see
+// reviewTransitions; otherwise it is skipped with the reason.
+// The sync stops at the first rate-limited response; the requests checked so far are kept.
+func (u *reviewUsecase) SyncReviews(ctx context.Context, opts SyncOptions) (*SyncResult, error) {
+	entries, err := u.historyRepo.FindAll(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("get histories: %w", err)
+	}
+
+	result := &SyncResult{}
+	for _, entry := range entries {
+		status := entry.CurrentStatus()
+		if status == entity.ReviewStatusDraft || status.IsTerminal() || len(entry.ReviewLinks) == 0 {
+			continue
+		}
+
+		review := &SyncedReview{Entry: entry, From: status, To: status}
+		result.Reviews = append(result.Reviews, review)
+		if !result.RateLimitReset.IsZero() {
+			review.Outcome = SyncNotChecked
+			continue
+		}
+
+		u.syncPullRequests(ctx, review)
+		var hostErr *CodeHostError
+		for _, change := range review.PullRequests {
+			if errors.As(change.Err, &hostErr) && hostErr.RateLimited() {
+				result.RateLimitReset = hostErr.RateLimitReset
+				review.Outcome = SyncNotChecked
+			}
+		}
+		if review.Outcome == SyncNotChecked || review.Outcome == SyncSkipped || review.Outcome == SyncUnchanged || opts.DryRun {
+			continue
+		}
+
+		if err := u.historyRepo.Update(ctx, entry); err != nil {
+			return result, fmt.Errorf("update %s: %w", entry.ID, err)
+		}
+	}
+
+	return result, nil
+}
+
+// syncPullRequests looks up the pull requests of a review and applies the outcome to its entry
+func (u *reviewUsecase) syncPullRequests(ctx context.Context, review *SyncedReview) {
+	entry := review.Entry
+
+	var fetched []PullRequestInfo
+	var reasons []string
+	merged, closed := 0, 0
+	for _, link := range entry.ReviewLinks {
+		change := PullRequestChange{Link: link, Old: entry.PullRequest(link)}
+		info, err := u.codeHost.GetPullRequest(ctx, link)
+		if err != nil {
+			change.Err = err
+			reasons = append(reasons, err.Error())
+			if change.Old != nil {
+				fetched = append(fetched, *change.Old)
+			}
+		} else {
+			change.New = info
+			fetched = append(fetched, *info)
+			switch info.State {
+			case entity.PullRequestStateMerged:
+				merged++
+			case entity.PullRequestStateClosed:
+				closed++
+			}
+		}
+		review.PullRequests = append(review.PullRequests, change)
+	}
+
+	changed := false
+	for _, change := range review.PullRequests {
+		changed = changed || change.Changed()
+	}
+
+	switch {
+	case len(reasons) == len(entry.ReviewLinks):
+		review.Outcome = SyncSkipped
+		review.Reason = strings.Join(dedupeStrings(reasons), "; ")
+		return
+	case len(reasons) == 0 && merged > 0 && merged+closed == len(entry.ReviewLinks):
+		review.Outcome = SyncMerged
+		review.To = entity.ReviewStatusMerged
+	case len(reasons) == 0 && closed == len(entry.ReviewLinks):
+		review.Outcome = SyncClosed
+		review.To = entity.ReviewStatusClosed
+	case changed:
+		review.Outcome = SyncUpdated
+	default:
+		review.Outcome = SyncUnchanged
+		return
+	}
+
+	// The code host is followed only where the lifecycle allows it
+	if review.To != review.From && !CanTransition(review.From, review.To) {
+		review.Outcome = SyncSkipped
+		review.Reason = fmt.Sprintf("pull requests are %s, but a %s request cannot be marked %s", review.To, review.From, review.To)
+		review.To = review.From
+		return
+	}
+
+	entry.PullRequests = fetched
+	if review.To == review.From {
+		return
+	}
+
+	now := time.Now()
+	eventType := entity.ReviewEventMerged
+	if review.To == entity.ReviewStatusClosed {
+		eventType = entity.ReviewEventClosed
+	}
+	applyStatus(entry, review.To, now)
+	entry.AppendEvent(entity.ReviewEvent{
+		Type:   eventType,
+		Actor:  config.GetConfig().UserName,
+		At:     now,
+		Status: review.To,
+		Note:   fmt.Sprintf("%d of %d pull request(s) merged, found by review sync", merged, len(entry.ReviewLinks)),
+	})
+}
+
+// dedupeStrings returns values without repeats, keeping the first occurrence
+func dedupeStrings(values []string) []string {
+	var unique []string
+	seen := map[string]bool{}
+	for _, value := range values {
+		if !seen[value] {
+			seen[value] = true
+			unique = append(unique, value)
+		}
+	}
+	return unique
+}
@@ -0,0 +1,83 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+
+	"github.com/yatbfi/cool/internal/domain/entity"
+)
+
+// stubCodeHost returns the same pull request state for every link
+type stubCodeHost struct {
+	state entity.PullRequestState
+}
+
+func (s stubCodeHost) GetPullRequest(_ context.Context, link string) (*PullRequestInfo, error) {
+	return &PullRequestInfo{Link: link, State: s.state}, nil
+}
+
+func (s stubCodeHost) FindOpenPullRequest(context.Context, string, string, string) (string, error) {
+	return "", nil
+}
+
+func TestSyncPullRequestsFollowsLifecycle(t *testing.T) {
+	tests := []struct {
+		name        string
+		status      entity.ReviewStatus
+		state       entity.PullRequestState
+		wantOutcome string
+		wantStatus  entity.ReviewStatus
+	}{
+		{
+			name:        "merged while in review",
+			status:      entity.ReviewStatusSubmitted,
+			state:       entity.PullRequestStateMerged,
+			wantOutcome: SyncMerged,
+			wantStatus:  entity.ReviewStatusMerged,
+		},
+		{
+			name:        "closed after architect approval",
+			status:      entity.ReviewStatusArchitectApproved,
+			state:       entity.PullRequestStateClosed,
+			wantOutcome: SyncClosed,
+			wantStatus:  entity.ReviewStatusClosed,
+		},
+		{
+			name:        "draft is not marked merged",
+			status:      entity.ReviewStatusDraft,
+			state:       entity.PullRequestStateMerged,
+			wantOutcome: SyncSkipped,
+			wantStatus:  entity.ReviewStatusDraft,
+		},
+		{
+			name:        "withdrawn is not reopened as closed",
+			status:      entity.ReviewStatusWithdrawn,
+			state:       entity.PullRequestStateClosed,
+			wantOutcome: SyncSkipped,
+			wantStatus:  entity.ReviewStatusWithdrawn,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			u := &reviewUsecase{codeHost: stubCodeHost{state: tt.state}}
+			entry := &ReviewHistoryEntry{
+				ID:          "rev-1",
+				Status:      tt.status,
+				ReviewLinks: []string{"https://github.com/org/pay/pull/1"},
+			}
+			review := &SyncedReview{Entry: entry, From: tt.status, To: tt.status}
+
+			u.syncPullRequests(context.Background(), review)
+			if review.Outcome != tt.wantOutcome {
+				t.Errorf("Outcome = %q, want %q", review.Outcome, tt.wantOutcome)
+			}
+			if got := entry.CurrentStatus(); got != tt.wantStatus {
+				t.Errorf("status = %q, want %q", got, tt.wantStatus)
+			}
+			if tt.wantOutcome == SyncSkipped && (review.Reason == "" || len(entry.Events) != 0) {
+				t.Errorf("skipped review: Reason = %q, Events = %d, want a reason and no events", review.Reason, len(entry.Events))
+			}
+		})
+	}
+}
@@ -0,0 +1,37 @@
+package usecase
+
+import (
+	"github.com/yatbfi/cool/config"
+	"github.com/yatbfi/cool/internal/pkg/common"
+)
+
+// RouteMatch is the routing rule selected for a review request (alias from config)
+type RouteMatch = config.RouteMatch
+
+// MatchRoute returns the route picking the channels of a review request, nil when the
+// channels of the stages are used
+func MatchRoute(entry *ReviewHistoryEntry) *RouteMatch {
+	return config.GetConfig().MatchRoute(newRouteTarget(entry.ReviewLinks, entry.JiraLinks, entry.Priority, entry.ProjectDir))
+}
+
+// RouteChannels returns the channels a stage of a review request fans out to
+func RouteChannels(entry *ReviewHistoryEntry, stage string) ([]config.Channel, error) {
+	return config.GetConfig().RouteChannels(MatchRoute(entry), stage)
+}
+
+// requestChannels returns the channels a stage of a not yet stored request fans out to
+func requestChannels(req *ReviewRequest, stage string) ([]config.Channel, error) {
+	cfg := config.GetConfig()
+	match := cfg.MatchRoute(newRouteTarget(req.ReviewLinks, req.JiraLinks, req.Priority, req.ProjectDir))
+	return cfg.RouteChannels(match, stage)
+}
+
+// newRouteTarget collects the repositories and Jira projects of the links of a request
+func newRouteTarget(reviewLinks, jiraLinks []string, priority, projectDir string) config.RouteTarget {
+	return config.RouteTarget{
+		Repositories: dedupeStrings(mapLinks(reviewLinks, common.ExtractRepository)),
+		JiraProjects: dedupeStrings(mapLinks(jiraLinks, common.ExtractJiraProject)),
+		Priority:     priority,
+		ProjectDir:   projectDir,
+	}
+}
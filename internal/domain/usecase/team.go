@@ -0,0 +1,75 @@
+package usecase
+
+import (
+	"strings"
+
+	"github.com/yatbfi/cool/config"
+	"github.com/yatbfi/cool/internal/domain/entity"
+)
+
+// Mention is a team member called out in a notification (alias from entity)
+type Mention = entity.Mention
+
+// stageReviewerRoles maps a review stage to the team role reviewing it
+var stageReviewerRoles = map[string]string{
+	config.StageReview: config.RoleTechLead,
+	config.StageCollab: config.RoleArchitect,
+}
+
+// Reviewers returns the team members reviewing a stage of a request: the tech leads for
+// review and the architects for collab, of the squad of the request's route or requester
+func Reviewers(entry *ReviewHistoryEntry, stage string) []config.TeamMember {
+	role, ok := stageReviewerRoles[stage]
+	if !ok {
+		return nil
+	}
+	return config.GetConfig().TeamReviewers(role, RequestSquad(entry), entry.SubmittedByEmail)
+}
+
+// RequestSquad returns the squad of a request: the squad of its route, or else the squad
+// of the requester in the team roster
+func RequestSquad(entry *ReviewHistoryEntry) string {
+	if match := MatchRoute(entry); match != nil && match.Route.Squad != "" {
+		return match.Route.Squad
+	}
+	if member, ok := config.GetConfig().FindTeamMember(entry.SubmittedByEmail); ok {
+		return member.Squad
+	}
+	return ""
+}
+
+// reviewerMentions returns the mentions of the reviewers of a stage of a request
+func reviewerMentions(entry *ReviewHistoryEntry, stage string) []Mention {
+	var mentions []Mention
+	for _, member := range Reviewers(entry, stage) {
+		mentions = append(mentions, Mention{Name: member.Name, GChatUserID: member.GChatUserID})
+	}
+	return mentions
+}
+
+// withReviewersField appends the names of the mentioned reviewers, if any
+func withReviewersField(fields []NotificationField, mentions []Mention) []NotificationField {
+	if len(mentions) == 0 {
+		return fields
+	}
+
+	names := make([]string, 0, len(mentions))
+	for _, m := range mentions {
+		names = append(names, m.Name)
+	}
+	return append(fields, NotificationField{Label: "Reviewers", Value: strings.Join(names, ", ")})
+}
+
+// formatGChatMentions renders mentions in Google Chat syntax, "<users/ID>", falling back to
+// "@Name" for members without a user ID
+func formatGChatMentions(mentions []Mention) string {
+	parts := make([]string, 0, len(mentions))
+	for _, m := range mentions {
+		if m.GChatUserID != "" {
+			parts = append(parts, "<users/"+m.GChatUserID+">")
+		} else {
+			parts = append(parts, "@"+m.Name)
+		}
+	}
+	return strings.Join(parts, " ")
+}
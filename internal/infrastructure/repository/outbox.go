@@ -0,0 +1,180 @@
+package repository
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+
+	"github.com/yatbfi/cool/internal/domain/entity"
+	domainRepo "github.com/yatbfi/cool/internal/domain/repository"
+)
+
+// outboxRepository implements OutboxRepository interface
+type outboxRepository struct {
+	filePath string
+	mu       sync.RWMutex
+}
+
+// NewOutboxRepository creates a new outbox repository
+func NewOutboxRepository() (domainRepo.OutboxRepository, error) {
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return nil, fmt.Errorf("get user home dir: %w", err)
+	}
+
+	configDir := filepath.Join(home, ".cool-cli")
+	filePath := filepath.Join(configDir, "outbox.json")
+
+	// Ensure directory exists
+	if err := os.MkdirAll(configDir, 0o700); err != nil {
+		return nil, fmt.Errorf("create config dir: %w", err)
+	}
+
+	repo := &outboxRepository{
+		filePath: filePath,
+	}
+
+	// Initialize file if it doesn't exist
+	if _, err := os.Stat(filePath); os.IsNotExist(err) {
+		if err := repo.writeMessages([]*entity.OutboxMessage{}); err != nil {
+			return nil, fmt.Errorf("initialize outbox file: %w", err)
+		}
+	}
+
+	return repo, nil
+}
+
+// Save saves new outbox messages
+func (r *outboxRepository) Save(_ context.Context, messages ...*entity.OutboxMessage) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	existing, err := r.readMessages()
+	if err != nil {
+		return err
+	}
+
+	ids := make(map[string]bool, len(existing))
+	for _, m := range existing {
+		ids[m.ID] = true
+	}
+	for _, m := range messages {
+		if ids[m.ID] {
+			return fmt.Errorf("outbox message with ID %s already exists", m.ID)
+		}
+		ids[m.ID] = true
+	}
+
+	return r.writeMessages(append(existing, messages...))
+}
+
+// Update updates an existing outbox message
+func (r *outboxRepository) Update(_ context.Context, message *entity.OutboxMessage) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	messages, err := r.readMessages()
+	if err != nil {
+		return err
+	}
+
+	for i, m := range messages {
+		if m.ID == message.ID {
+			messages[i] = message
+			return r.writeMessages(messages)
+		}
+	}
+
+	return fmt.Errorf("outbox message with ID %s not found", message.ID)
+}
+
+// FindByID retrieves an outbox message by ID
+func (r *outboxRepository) FindByID(_ context.Context, id string) (*entity.OutboxMessage, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	messages, err := r.readMessages()
+	if err != nil {
+		return nil, err
+	}
+
+	for _, m := range messages {
+		if m.ID == id {
+			return m, nil
+		}
+	}
+
+	return nil, fmt.Errorf("outbox message with ID %s not found", id)
+}
+
+// FindAll retrieves all outbox messages, oldest first
+func (r *outboxRepository) FindAll(_ context.Context) ([]*entity.OutboxMessage, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	messages, err := r.readMessages()
+	if err != nil {
+		return nil, err
+	}
+
+	sort.SliceStable(messages, func(i, j int) bool {
+		return messages[i].CreatedAt.Before(messages[j].CreatedAt)
+	})
+	return messages, nil
+}
+
+// Delete deletes an outbox message by ID
+func (r *outboxRepository) Delete(_ context.Context, id string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	messages, err := r.readMessages()
+	if err != nil {
+		return err
+	}
+
+	for i, m := range messages {
+		if m.ID == id {
+			return r.writeMessages(append(messages[:i], messages[i+1:]...))
+		}
+	}
+
+	return fmt.Errorf("outbox message with ID %s not found", id)
+}
+
+// readMessages reads all outbox messages from JSON file
+func (r *outboxRepository) readMessages() ([]*entity.OutboxMessage, error) {
+	data, err := os.ReadFile(r.filePath)
+	if err != nil {
+		return nil, fmt.Errorf("read outbox file: %w", err)
+	}
+
+	var messages []*entity.OutboxMessage
+	if err := json.Unmarshal(data, &messages); err != nil {
+		return nil, fmt.Errorf("unmarshal outbox: %w", err)
+	}
+
+	return messages, nil
+}
+
+// writeMessages writes all outbox messages to JSON file
+func (r *outboxRepository) writeMessages(messages []*entity.OutboxMessage) error {
+	data, err := json.MarshalIndent(messages, "", "  ")
+	if err != nil {
+		return fmt.Errorf("marshal outbox: %w", err)
+	}
+
+	// Pending messages carry webhook threads and user emails, so the file is private like config.json
+	if err := os.WriteFile(r.filePath, data, 0o600); err != nil {
+		return fmt.Errorf("write outbox file: %w", err)
+	}
+	if err := os.Chmod(r.filePath, 0o600); err != nil {
+		return fmt.Errorf("secure outbox file: %w", err)
+	}
+
+	return nil
+}
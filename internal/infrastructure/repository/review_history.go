@@ -1,15 +1,20 @@
 package repository
 
 import (
+	"cmp"
 	"context"
 	"encoding/json"
 	"fmt"
+	"math"
 	"os"
 	"path/filepath"
+	"slices"
+	"strings"
 	"sync"
 
 	"github.com/yatbfi/cool/internal/domain/entity"
 	domainRepo "github.com/yatbfi/cool/internal/domain/repository"
+	"github.com/yatbfi/cool/internal/pkg/common"
 )
 
 // reviewHistoryRepository implements ReviewHistoryRepository interface
@@ -29,7 +34,7 @@ func NewReviewHistoryRepository() (domainRepo.ReviewHistoryRepository, error) {
 	filePath := filepath.Join(configDir, "review_histories.json")
 
 	// Ensure directory exists
-	if err := os.MkdirAll(configDir, 0o755); err != nil {
+	if err := os.MkdirAll(configDir, 0o700); err != nil {
 		return nil, fmt.Errorf("create config dir: %w", err)
 	}
 
@@ -113,6 +118,30 @@ func (r *reviewHistoryRepository) FindByID(_ context.Context, id string) (*entit
 	return nil, fmt.Errorf("entry with ID %s not found", id)
 }
 
+// FindByIDPrefix retrieves the entries whose ID starts with the given prefix.
+// An exact ID match is returned alone.
+func (r *reviewHistoryRepository) FindByIDPrefix(_ context.Context, prefix string) ([]*entity.ReviewHistoryEntry, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	histories, err := r.readHistories()
+	if err != nil {
+		return nil, err
+	}
+
+	var matches []*entity.ReviewHistoryEntry
+	for _, h := range histories {
+		if h.ID == prefix {
+			return []*entity.ReviewHistoryEntry{h}, nil
+		}
+		if strings.HasPrefix(h.ID, prefix) {
+			matches = append(matches, h)
+		}
+	}
+
+	return matches, nil
+}
+
 // FindAll retrieves all review history entries
 func (r *reviewHistoryRepository) FindAll(_ context.Context) ([]*entity.ReviewHistoryEntry, error) {
 	r.mu.RLock()
@@ -141,6 +170,31 @@ func (r *reviewHistoryRepository) FindByCollabStatus(_ context.Context, submitte
 	return filtered, nil
 }
 
+// FindByCriteria retrieves the review history entries matching the criteria, sorted and limited
+func (r *reviewHistoryRepository) FindByCriteria(_ context.Context, criteria domainRepo.ReviewHistoryCriteria) ([]*entity.ReviewHistoryEntry, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	histories, err := r.readHistories()
+	if err != nil {
+		return nil, err
+	}
+
+	var filtered []*entity.ReviewHistoryEntry
+	for _, h := range histories {
+		if matchesCriteria(h, criteria) {
+			filtered = append(filtered, h)
+		}
+	}
+
+	sortHistories(filtered, criteria.Sort)
+	if criteria.Limit > 0 && len(filtered) > criteria.Limit {
+		filtered = filtered[:criteria.Limit]
+	}
+
+	return filtered, nil
+}
+
 // Delete deletes a review history entry by ID
 func (r *reviewHistoryRepository) Delete(_ context.Context, id string) error {
 	r.mu.Lock()
@@ -190,9 +244,81 @@ func (r *reviewHistoryRepository) writeHistories(histories []*entity.ReviewHisto
 		return fmt.Errorf("marshal histories: %w", err)
 	}
 
-	if err := os.WriteFile(r.filePath, data, 0o644); err != nil {
+	// Entries hold chat threads and user emails; keep the file owner-only like config.json
+	if err := os.WriteFile(r.filePath, data, 0o600); err != nil {
 		return fmt.Errorf("write history file: %w", err)
 	}
+	if err := os.Chmod(r.filePath, 0o600); err != nil {
+		return fmt.Errorf("secure history file: %w", err)
+	}
 
 	return nil
 }
+
+// matchesCriteria reports whether an entry satisfies every set criterion
+func matchesCriteria(h *entity.ReviewHistoryEntry, c domainRepo.ReviewHistoryCriteria) bool {
+	if (h.CurrentStatus() == entity.ReviewStatusDraft) != c.Drafts {
+		return false
+	}
+	if c.SubmittedToCollab != nil && h.SubmittedToCollab != *c.SubmittedToCollab {
+		return false
+	}
+	if len(c.Priorities) > 0 && !slices.ContainsFunc(c.Priorities, func(p string) bool { return strings.EqualFold(p, strings.TrimSpace(h.Priority)) }) {
+		return false
+	}
+	if c.SubmittedSince != nil && h.SubmittedAt.Before(*c.SubmittedSince) {
+		return false
+	}
+	if c.SubmittedUntil != nil && !h.SubmittedAt.Before(*c.SubmittedUntil) {
+		return false
+	}
+	if c.JiraKey != "" && !slices.ContainsFunc(h.JiraLinks, func(link string) bool {
+		return strings.EqualFold(common.ExtractJiraTicketNumber(link), c.JiraKey) || strings.EqualFold(common.ExtractJiraProject(link), c.JiraKey)
+	}) {
+		return false
+	}
+	if c.Repository != "" && !slices.ContainsFunc(h.ReviewLinks, func(link string) bool {
+		return strings.EqualFold(common.ExtractRepository(link), strings.Trim(c.Repository, "/"))
+	}) {
+		return false
+	}
+	if c.Search != "" {
+		search := strings.ToLower(c.Search)
+		if !strings.Contains(strings.ToLower(h.Title), search) && !strings.Contains(strings.ToLower(h.Description), search) {
+			return false
+		}
+	}
+	return true
+}
+
+// sortHistories orders entries in place; HistorySortNone keeps the storage order
+func sortHistories(histories []*entity.ReviewHistoryEntry, order domainRepo.HistorySort) {
+	newestFirst := func(a, b *entity.ReviewHistoryEntry) int {
+		return b.SubmittedAt.Compare(a.SubmittedAt)
+	}
+
+	switch order {
+	case domainRepo.HistorySortSubmitted:
+		slices.SortStableFunc(histories, newestFirst)
+	case domainRepo.HistorySortAge:
+		slices.SortStableFunc(histories, func(a, b *entity.ReviewHistoryEntry) int {
+			return a.SubmittedAt.Compare(b.SubmittedAt)
+		})
+	case domainRepo.HistorySortPriority:
+		slices.SortStableFunc(histories, func(a, b *entity.ReviewHistoryEntry) int {
+			if c := cmp.Compare(priorityRank(a.Priority), priorityRank(b.Priority)); c != 0 {
+				return c
+			}
+			return newestFirst(a, b)
+		})
+	}
+}
+
+// priorityRank orders "P0" before "P1" and so on, with unknown priorities last
+func priorityRank(priority string) int {
+	priority = strings.ToUpper(strings.TrimSpace(priority))
+	if len(priority) == 2 && priority[0] == 'P' && priority[1] >= '0' && priority[1] <= '9' {
+		return int(priority[1] - '0')
+	}
+	return math.MaxInt
+}
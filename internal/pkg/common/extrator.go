@@ -1,6 +1,9 @@
 package common
 
-import "strings"
+import (
+	"net/url"
+	"strings"
+)
 
 func ExtractJiraTicketNumber(url string) string {
 	parts := strings.Split(url, "/browse/")
@@ -14,8 +17,11 @@ func ExtractJiraTicketNumber(url string) string {
 	return ticket
 }
 
+// PRPathSegments precede the number in GitHub, GitLab and Bitbucket pull request links
+var PRPathSegments = []string{"/pull/", "/merge_requests/", "/pull-requests/"}
+
 func ExtractPRNumber(url string) string {
-	for _, segment := range []string{"/pull/", "/merge_requests/"} { // handles GitHub and GitLab
+	for _, segment := range PRPathSegments {
 		if strings.Contains(url, segment) {
 			parts := strings.Split(url, segment)
 			if len(parts) < 2 {
@@ -30,3 +36,29 @@ func ExtractPRNumber(url string) string {
 	}
 	return ""
 }
+
+// ExtractJiraProject returns the project key of a Jira link, e.g. "PROJ" for PROJ-123
+func ExtractJiraProject(url string) string {
+	ticket := ExtractJiraTicketNumber(url)
+	if idx := strings.LastIndex(ticket, "-"); idx > 0 {
+		return ticket[:idx]
+	}
+	return ticket
+}
+
+// ExtractRepository returns the repository path of a pull request link, e.g. "owner/repo"
+// for GitHub and Bitbucket or "group/subgroup/repo" for GitLab
+func ExtractRepository(link string) string {
+	u, err := url.Parse(strings.TrimSpace(link))
+	if err != nil || u.Host == "" {
+		return ""
+	}
+
+	path := strings.Trim(u.Path, "/")
+	for _, segment := range []string{"/-/merge_requests/", "/merge_requests/", "/pull/", "/pull-requests/"} {
+		if idx := strings.Index("/"+path+"/", segment); idx >= 0 {
+			return path[:max(idx-1, 0)]
+		}
+	}
+	return ""
+}
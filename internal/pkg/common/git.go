@@ -0,0 +1,131 @@
+package common
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"os/exec"
+	"strings"
+)
+
+// ErrNotGitRepository is returned when a directory is not inside a git work tree
+var ErrNotGitRepository = errors.New("not inside a git work tree")
+
+// gitLogLimit caps the commits read from a branch
+const gitLogLimit = 50
+
+// GitCommit is a commit on the current branch
+type GitCommit struct {
+	Hash    string // abbreviated
+	Subject string
+}
+
+// GitBranchInfo describes the checked-out branch of a git work tree
+type GitBranchInfo struct {
+	Branch     string
+	Remote     string      // e.g. "origin"
+	RemoteURL  string      // fetch URL of Remote, empty when there is none
+	MergeRef   string      // upstream ref, e.g. "refs/pull/42/head" after `gh pr checkout 42`
+	BaseBranch string      // e.g. "origin/main", empty when none was found
+	Commits    []GitCommit // commits since BaseBranch, oldest first
+}
+
+// ReadGitBranchInfo reads the current branch, its remote and its commits since the base
+// branch (the remote's default branch, or main, master or develop)
+func ReadGitBranchInfo(dir string) (*GitBranchInfo, error) {
+	if out, err := runGit(dir, "rev-parse", "--is-inside-work-tree"); err != nil || out != "true" {
+		return nil, ErrNotGitRepository
+	}
+
+	branch, err := runGit(dir, "symbolic-ref", "--short", "-q", "HEAD")
+	if err != nil || branch == "" {
+		return nil, fmt.Errorf("no branch is checked out (detached HEAD)")
+	}
+
+	info := &GitBranchInfo{Branch: branch, Remote: "origin"}
+	if remote, err := runGit(dir, "config", "branch."+branch+".remote"); err == nil && remote != "" && remote != "." {
+		info.Remote = remote
+	}
+	info.RemoteURL, _ = runGit(dir, "remote", "get-url", info.Remote)
+	info.MergeRef, _ = runGit(dir, "config", "branch."+branch+".merge")
+
+	info.BaseBranch = findBaseBranch(dir, info.Remote, branch)
+	if info.BaseBranch == "" {
+		return info, nil
+	}
+
+	log, err := runGit(dir, "log", "--no-merges", "--reverse", fmt.Sprintf("-n%d", gitLogLimit), "--format=%h%x1f%s", info.BaseBranch+"..HEAD")
+	if err != nil {
+		return nil, fmt.Errorf("read commits: %w", err)
+	}
+	for _, line := range strings.Split(log, "\n") {
+		if hash, subject, ok := strings.Cut(line, "\x1f"); ok {
+			info.Commits = append(info.Commits, GitCommit{Hash: hash, Subject: subject})
+		}
+	}
+	return info, nil
+}
+
+// findBaseBranch returns the branch the current one was most likely started from
+func findBaseBranch(dir, remote, branch string) string {
+	if head, err := runGit(dir, "symbolic-ref", "--short", "-q", "refs/remotes/"+remote+"/HEAD"); err == nil && head != "" {
+		if head != remote+"/"+branch {
+			return head
+		}
+		return ""
+	}
+
+	for _, name := range []string{"main", "master", "develop"} {
+		if name == branch {
+			return ""
+		}
+		for _, ref := range []string{remote + "/" + name, name} {
+			if _, err := runGit(dir, "rev-parse", "--verify", "-q", ref); err == nil {
+				return ref
+			}
+		}
+	}
+	return ""
+}
+
+// GitRemoteRepository splits a remote URL into its host and repository path, e.g.
+// "git@github.com:org/repo.git" into "github.com" and "org/repo"
+func GitRemoteRepository(remoteURL string) (host, path string, ok bool) {
+	remoteURL = strings.TrimSpace(remoteURL)
+	if remoteURL == "" {
+		return "", "", false
+	}
+
+	if !strings.Contains(remoteURL, "://") {
+		// scp-like syntax: [user@]host:path
+		userHost, p, found := strings.Cut(remoteURL, ":")
+		if !found {
+			return "", "", false
+		}
+		if i := strings.LastIndex(userHost, "@"); i >= 0 {
+			userHost = userHost[i+1:]
+		}
+		host, path = userHost, p
+	} else {
+		u, err := url.Parse(remoteURL)
+		if err != nil {
+			return "", "", false
+		}
+		host, path = u.Hostname(), u.Path
+	}
+
+	path = strings.TrimSuffix(strings.Trim(path, "/"), ".git")
+	if host == "" || path == "" {
+		return "", "", false
+	}
+	return strings.ToLower(host), path, true
+}
+
+func runGit(dir string, args ...string) (string, error) {
+	cmd := exec.Command("git", append([]string{"-C", dir}, args...)...)
+	out, err := cmd.Output()
+	if err != nil {
+		return "", err
+	}
+	return strings.TrimSpace(string(out)), nil
+}
@@ -0,0 +1,44 @@
+package common
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ParseTimeExpr parses an absolute or relative point in time:
+//   - a date ("2025-11-01") or RFC 3339 timestamp
+//   - a relative age ago from now: "36h", "7d", "2w"
+//
+// With endOfDay set, a plain date means the end of that day, which makes it an
+// inclusive upper bound for --until style flags.
+func ParseTimeExpr(value string, now time.Time, endOfDay bool) (time.Time, error) {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return time.Time{}, fmt.Errorf("empty time")
+	}
+
+	if t, err := time.Parse(time.RFC3339, value); err == nil {
+		return t, nil
+	}
+	if t, err := time.ParseInLocation("2006-01-02", value, now.Location()); err == nil {
+		if endOfDay {
+			t = t.AddDate(0, 0, 1)
+		}
+		return t, nil
+	}
+
+	units := map[string]time.Duration{"h": time.Hour, "d": 24 * time.Hour, "w": 7 * 24 * time.Hour}
+	for suffix, unit := range units {
+		if number, ok := strings.CutSuffix(value, suffix); ok {
+			n, err := strconv.Atoi(number)
+			if err != nil || n < 0 {
+				break
+			}
+			return now.Add(-time.Duration(n) * unit), nil
+		}
+	}
+
+	return time.Time{}, fmt.Errorf("invalid time %q (use YYYY-MM-DD, RFC 3339 or a relative age like 7d, 2w, 36h)", value)
+}